@@ -0,0 +1,297 @@
+package client
+
+import (
+	"container/list"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/0ffsideCompass/api-football-go-client/models"
+)
+
+// Cache stores raw response bodies keyed by request URL. Implementations
+// must be safe for concurrent use.
+type Cache interface {
+	// Get returns the body stored under key, if it exists and has not
+	// expired.
+	Get(key string) ([]byte, bool)
+	// Set stores body under key for ttl.
+	Set(key string, body []byte, ttl time.Duration)
+}
+
+// oneDay is the unit of the default cache TTLs.
+const oneDay = 24 * time.Hour
+
+// defaultCacheTTLs is how long responses of each endpoint are cached when a
+// Cache is configured. Endpoints not listed are not cached. Fixtures are only
+// cached for queries whose result cannot grow (see closedFixtureQuery), and
+// once every fixture in the response is finished, since live and upcoming
+// fixtures change.
+var defaultCacheTTLs = map[string]time.Duration{
+	timezoneEndpoint:       30 * oneDay,
+	countriesEndpoint:      30 * oneDay,
+	teamsCountriesEndpoint: 30 * oneDay,
+	leaguesSeasonsEndpoint: oneDay,
+	leaguesEndpoint:        oneDay,
+	teamsSeasonsEndpoint:   oneDay,
+	venuesEndpoint:         7 * oneDay,
+	fixtureRoundsEndpoint:  oneDay,
+	fixtureEndpoint:        30 * oneDay,
+	oddsBookmakersEndpoint: oneDay,
+	oddsBetsEndpoint:       oneDay,
+	oddsLiveBetsEndpoint:   oneDay,
+	// Listed for clarity: in-play odds and head-to-heads including upcoming
+	// fixtures must never be served stale.
+	oddsLiveEndpoint:          0,
+	fixtureHeadToHeadEndpoint: 0,
+}
+
+// WithCache enables response caching in cache. What is cached, and for how
+// long, follows a per-endpoint TTL table (see WithCacheTTL).
+func WithCache(cache Cache) Option {
+	return func(c *Client) error {
+		c.cache = cache
+		return nil
+	}
+}
+
+// WithCacheTTL overrides the cache TTL of an endpoint, given by its path
+// (for example "leagues" or "fixtures/lineups"). A TTL of zero disables
+// caching for that endpoint.
+func WithCacheTTL(endpoint string, ttl time.Duration) Option {
+	return func(c *Client) error {
+		if ttl < 0 {
+			return errors.New("negative cache ttl")
+		}
+		if c.cacheTTLs == nil {
+			c.cacheTTLs = make(map[string]time.Duration, len(defaultCacheTTLs))
+			for k, v := range defaultCacheTTLs {
+				c.cacheTTLs[k] = v
+			}
+		}
+		c.cacheTTLs[strings.Trim(endpoint, "/")] = ttl
+		return nil
+	}
+}
+
+type cacheTTLKey struct{}
+
+// ContextWithCacheTTL returns a copy of ctx that overrides the cache TTL of
+// the calls it is passed to. A TTL of zero bypasses the cache entirely: the
+// cached entry is neither read nor replaced.
+func ContextWithCacheTTL(ctx context.Context, ttl time.Duration) context.Context {
+	return context.WithValue(ctx, cacheTTLKey{}, ttl)
+}
+
+// cachePolicy returns the TTL for a request URL, and whether the response
+// may be stored only when all its fixtures are finished. The
+// finished-fixture check also applies under a per-call TTL, while the
+// table's fixtures TTL only applies to closed queries.
+func (c *Client) cachePolicy(ctx context.Context, rawURL string) (time.Duration, bool) {
+	path, query, _ := strings.Cut(strings.TrimPrefix(rawURL, c.Domain), "?")
+	endpoint := strings.Trim(path, "/")
+	finishedOnly := endpoint == fixtureEndpoint
+	if ttl, ok := ctx.Value(cacheTTLKey{}).(time.Duration); ok {
+		return ttl, finishedOnly
+	}
+	if finishedOnly {
+		params, err := url.ParseQuery(query)
+		if err != nil || !closedFixtureQuery(params, time.Now()) {
+			return 0, false
+		}
+	}
+	ttls := c.cacheTTLs
+	if ttls == nil {
+		ttls = defaultCacheTTLs
+	}
+	return ttls[endpoint], finishedOnly
+}
+
+// closedFixtureQuery reports whether a /fixtures query selects a set of
+// fixtures that cannot change: fixtures by ID, or a date or from/to range
+// that has ended in every timezone. Queries with last, next, live or status,
+// and those only filtering by team, league or season, keep returning new
+// fixtures and are never closed.
+func closedFixtureQuery(params url.Values, now time.Time) bool {
+	for _, open := range []string{"last", "next", "live", "status"} {
+		if params.Has(open) {
+			return false
+		}
+	}
+	if params.Has("id") || params.Has("ids") {
+		return true
+	}
+	last := params.Get("date")
+	if last == "" {
+		last = params.Get("to")
+	}
+	day, err := time.Parse(time.DateOnly, last)
+	// A day ends at most 36 hours after its UTC midnight, in UTC-12.
+	return err == nil && day.Add(36*time.Hour).Before(now)
+}
+
+// allFixturesFinished reports whether body is a fixtures response in which
+// every fixture has a final status.
+func allFixturesFinished(body []byte) bool {
+	var resp struct {
+		Response []struct {
+			Fixture struct {
+				Status struct {
+					Short models.FixtureStatus `json:"short"`
+				} `json:"status"`
+			} `json:"fixture"`
+		} `json:"response"`
+	}
+	if err := json.Unmarshal(body, &resp); err != nil || len(resp.Response) == 0 {
+		return false
+	}
+	for _, f := range resp.Response {
+		if !f.Fixture.Status.Short.IsFinal() {
+			return false
+		}
+	}
+	return true
+}
+
+// MemoryCache is an in-memory Cache that evicts the least recently used
+// entry once it holds its maximum number of entries.
+type MemoryCache struct {
+	mu         sync.Mutex
+	maxEntries int
+	ll         *list.List
+	items      map[string]*list.Element
+}
+
+type memoryEntry struct {
+	key     string
+	body    []byte
+	expires time.Time
+}
+
+// NewMemoryCache returns a MemoryCache holding at most maxEntries responses.
+// A maxEntries of zero or less means no limit.
+func NewMemoryCache(maxEntries int) *MemoryCache {
+	return &MemoryCache{
+		maxEntries: maxEntries,
+		ll:         list.New(),
+		items:      make(map[string]*list.Element),
+	}
+}
+
+// Get implements Cache.
+func (m *MemoryCache) Get(key string) ([]byte, bool) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	el, ok := m.items[key]
+	if !ok {
+		return nil, false
+	}
+	entry := el.Value.(*memoryEntry)
+	if time.Now().After(entry.expires) {
+		m.ll.Remove(el)
+		delete(m.items, key)
+		return nil, false
+	}
+	m.ll.MoveToFront(el)
+	return entry.body, true
+}
+
+// Set implements Cache.
+func (m *MemoryCache) Set(key string, body []byte, ttl time.Duration) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	expires := time.Now().Add(ttl)
+	if el, ok := m.items[key]; ok {
+		entry := el.Value.(*memoryEntry)
+		entry.body, entry.expires = body, expires
+		m.ll.MoveToFront(el)
+		return
+	}
+	m.items[key] = m.ll.PushFront(&memoryEntry{key: key, body: body, expires: expires})
+	if m.maxEntries > 0 && m.ll.Len() > m.maxEntries {
+		oldest := m.ll.Back()
+		m.ll.Remove(oldest)
+		delete(m.items, oldest.Value.(*memoryEntry).key)
+	}
+}
+
+// Len returns the number of entries in the cache, including expired ones
+// that have not been evicted yet.
+func (m *MemoryCache) Len() int {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return m.ll.Len()
+}
+
+// FileCache is a Cache that stores each response in its own file in a
+// directory, so cached data survives restarts. File names are hashes of the
+// keys; each file starts with its expiry time on the first line.
+type FileCache struct {
+	dir string
+}
+
+// NewFileCache returns a FileCache storing its files in dir, which is
+// created if needed.
+func NewFileCache(dir string) (*FileCache, error) {
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return nil, err
+	}
+	return &FileCache{dir: dir}, nil
+}
+
+func (f *FileCache) path(key string) string {
+	sum := sha256.Sum256([]byte(key))
+	return filepath.Join(f.dir, hex.EncodeToString(sum[:]))
+}
+
+// Get implements Cache.
+func (f *FileCache) Get(key string) ([]byte, bool) {
+	data, err := os.ReadFile(f.path(key))
+	if err != nil {
+		return nil, false
+	}
+	header, body, ok := strings.Cut(string(data), "\n")
+	if !ok {
+		return nil, false
+	}
+	expires, err := time.Parse(time.RFC3339Nano, header)
+	if err != nil || time.Now().After(expires) {
+		_ = os.Remove(f.path(key))
+		return nil, false
+	}
+	return []byte(body), true
+}
+
+// Set implements Cache. Errors writing the file are ignored; the response
+// is then simply not cached.
+func (f *FileCache) Set(key string, body []byte, ttl time.Duration) {
+	tmp, err := os.CreateTemp(f.dir, ".tmp-*")
+	if err != nil {
+		return
+	}
+	header := time.Now().Add(ttl).Format(time.RFC3339Nano) + "\n"
+	_, err = tmp.WriteString(header)
+	if err == nil {
+		_, err = tmp.Write(body)
+	}
+	if closeErr := tmp.Close(); err == nil {
+		err = closeErr
+	}
+	// Rename atomically so concurrent readers never see a partial file.
+	if err == nil {
+		err = os.Rename(tmp.Name(), f.path(key))
+	}
+	if err != nil {
+		_ = os.Remove(tmp.Name())
+	}
+}
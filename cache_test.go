@@ -0,0 +1,206 @@
+package client_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	client "github.com/0ffsideCompass/api-football-go-client"
+)
+
+// emptyBody is a successful response without results.
+const emptyBody = `{"errors": [], "results": 0, "response": []}`
+
+func newCachingClient(t *testing.T, body string, opts ...client.Option) (*client.Client, *MockHTTPClient) {
+	t.Helper()
+	httpClient := &MockHTTPClient{Respond: respondWith(http.StatusOK, nil, body)}
+	opts = append([]client.Option{client.WithHTTPClient(httpClient)}, opts...)
+	apiClient, err := client.NewClient("test-key", opts...)
+	assert.NoError(t, err)
+	return apiClient, httpClient
+}
+
+func TestCacheServesRepeatedRequests(t *testing.T) {
+	apiClient, httpClient := newCachingClient(t, emptyBody, client.WithCache(client.NewMemoryCache(10)))
+
+	for i := 0; i < 3; i++ {
+		_, err := apiClient.Timezone()
+		assert.NoError(t, err)
+	}
+	assert.Equal(t, 1, httpClient.Calls)
+
+	// Different parameters are a different key.
+	_, err := apiClient.Countries(map[string]any{"name": "England"})
+	assert.NoError(t, err)
+	_, err = apiClient.Countries(map[string]any{"name": "France"})
+	assert.NoError(t, err)
+	assert.Equal(t, 3, httpClient.Calls)
+}
+
+func TestCacheSkipsUncachedEndpoints(t *testing.T) {
+	apiClient, httpClient := newCachingClient(t, emptyBody, client.WithCache(client.NewMemoryCache(10)))
+
+	for i := 0; i < 2; i++ {
+		_, err := apiClient.OddsLive(map[string]any{"fixture": 1})
+		assert.NoError(t, err)
+	}
+	assert.Equal(t, 2, httpClient.Calls)
+}
+
+func TestCacheStoresOnlyFinishedFixtures(t *testing.T) {
+	finished := `{"errors": [], "results": 2, "response": [
+		{"fixture": {"id": 1, "status": {"short": "FT"}}},
+		{"fixture": {"id": 2, "status": {"short": "PEN"}}}
+	]}`
+	live := `{"errors": [], "results": 2, "response": [
+		{"fixture": {"id": 1, "status": {"short": "FT"}}},
+		{"fixture": {"id": 3, "status": {"short": "2H"}}}
+	]}`
+
+	apiClient, httpClient := newCachingClient(t, finished, client.WithCache(client.NewMemoryCache(10)))
+	for i := 0; i < 2; i++ {
+		_, err := apiClient.Fixture(map[string]any{"ids": "1-2"})
+		assert.NoError(t, err)
+	}
+	assert.Equal(t, 1, httpClient.Calls)
+
+	apiClient, httpClient = newCachingClient(t, live, client.WithCache(client.NewMemoryCache(10)))
+	for i := 0; i < 2; i++ {
+		_, err := apiClient.Fixture(map[string]any{"ids": "1-3"})
+		assert.NoError(t, err)
+	}
+	assert.Equal(t, 2, httpClient.Calls)
+
+	// A per-call TTL does not lift the check.
+	ctx := client.ContextWithCacheTTL(context.Background(), time.Hour)
+	apiClient, httpClient = newCachingClient(t, live, client.WithCache(client.NewMemoryCache(10)))
+	for i := 0; i < 2; i++ {
+		_, err := apiClient.FixtureContext(ctx, map[string]any{"live": "all"})
+		assert.NoError(t, err)
+	}
+	assert.Equal(t, 2, httpClient.Calls)
+}
+
+func TestCacheFixtureQueries(t *testing.T) {
+	finished := `{"errors": [], "results": 1, "response": [{"fixture": {"id": 1, "status": {"short": "FT"}}}]}`
+	tests := []struct {
+		name   string
+		params map[string]any
+		cached bool
+	}{
+		{"by id", map[string]any{"id": 1}, true},
+		{"past date", map[string]any{"league": 39, "season": 2023, "date": "2023-08-12"}, true},
+		{"past range", map[string]any{"league": 39, "season": 2023, "from": "2023-08-01", "to": "2023-08-31"}, true},
+		{"open range", map[string]any{"league": 39, "season": 2023, "from": "2023-08-01", "to": "2999-08-31"}, false},
+		{"last", map[string]any{"team": 33, "last": 5}, false},
+		{"status", map[string]any{"league": 39, "season": 2023, "status": "FT-AET-PEN"}, false},
+		{"season", map[string]any{"league": 39, "season": 2023}, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			apiClient, httpClient := newCachingClient(t, finished, client.WithCache(client.NewMemoryCache(10)))
+			for i := 0; i < 2; i++ {
+				_, err := apiClient.Fixture(tt.params)
+				assert.NoError(t, err)
+			}
+			if tt.cached {
+				assert.Equal(t, 1, httpClient.Calls)
+			} else {
+				assert.Equal(t, 2, httpClient.Calls)
+			}
+		})
+	}
+}
+
+func TestCacheDoesNotStoreAPIErrors(t *testing.T) {
+	body := `{"errors": {"token": "Missing application key."}, "results": 0, "response": []}`
+	apiClient, httpClient := newCachingClient(t, body, client.WithCache(client.NewMemoryCache(10)))
+
+	for i := 0; i < 2; i++ {
+		_, err := apiClient.Timezone()
+		assert.ErrorIs(t, err, client.ErrAuth)
+	}
+	assert.Equal(t, 2, httpClient.Calls)
+}
+
+func TestCacheTTLOverrides(t *testing.T) {
+	t.Run("per endpoint", func(t *testing.T) {
+		apiClient, httpClient := newCachingClient(t, emptyBody,
+			client.WithCache(client.NewMemoryCache(10)),
+			client.WithCacheTTL("fixtures/lineups", time.Hour),
+			client.WithCacheTTL("timezone", 0),
+		)
+		for i := 0; i < 2; i++ {
+			_, err := apiClient.FixturesLineups(map[string]any{"fixture": 1})
+			assert.NoError(t, err)
+			_, err = apiClient.Timezone()
+			assert.NoError(t, err)
+		}
+		assert.Equal(t, 3, httpClient.Calls)
+	})
+
+	t.Run("per call", func(t *testing.T) {
+		apiClient, httpClient := newCachingClient(t, emptyBody, client.WithCache(client.NewMemoryCache(10)))
+
+		ctx := client.ContextWithCacheTTL(context.Background(), time.Hour)
+		for i := 0; i < 2; i++ {
+			_, err := apiClient.OddsLiveContext(ctx, map[string]any{"fixture": 1})
+			assert.NoError(t, err)
+		}
+		assert.Equal(t, 1, httpClient.Calls)
+
+		// A zero TTL bypasses an existing entry.
+		_, err := apiClient.OddsLiveContext(client.ContextWithCacheTTL(ctx, 0), map[string]any{"fixture": 1})
+		assert.NoError(t, err)
+		assert.Equal(t, 2, httpClient.Calls)
+	})
+
+	t.Run("negative", func(t *testing.T) {
+		_, err := client.NewClient("key", client.WithCacheTTL("timezone", -time.Second))
+		assert.EqualError(t, err, "negative cache ttl")
+	})
+}
+
+func TestMemoryCache(t *testing.T) {
+	cache := client.NewMemoryCache(2)
+	cache.Set("a", []byte("1"), time.Hour)
+	cache.Set("b", []byte("2"), time.Hour)
+
+	// Reading "a" makes "b" the least recently used entry.
+	got, ok := cache.Get("a")
+	assert.True(t, ok)
+	assert.Equal(t, []byte("1"), got)
+
+	cache.Set("c", []byte("3"), time.Hour)
+	assert.Equal(t, 2, cache.Len())
+	_, ok = cache.Get("b")
+	assert.False(t, ok)
+
+	cache.Set("d", []byte("4"), -time.Second)
+	_, ok = cache.Get("d")
+	assert.False(t, ok)
+}
+
+func TestFileCache(t *testing.T) {
+	dir := t.TempDir()
+	cache, err := client.NewFileCache(dir)
+	assert.NoError(t, err)
+
+	cache.Set("https://example.com/timezone", []byte(emptyBody), time.Hour)
+	cache.Set("expired", []byte("x"), -time.Second)
+
+	// A new instance on the same directory sees the stored entries.
+	reopened, err := client.NewFileCache(dir)
+	assert.NoError(t, err)
+	got, ok := reopened.Get("https://example.com/timezone")
+	assert.True(t, ok)
+	assert.Equal(t, []byte(emptyBody), got)
+
+	_, ok = reopened.Get("expired")
+	assert.False(t, ok)
+	_, ok = reopened.Get("missing")
+	assert.False(t, ok)
+}
@@ -6,9 +6,6 @@ import (
 	"fmt"
 	"io"
 	"net/http"
-	"net/url"
-	"strconv"
-	"strings"
 	"time"
 )
 
@@ -19,7 +16,7 @@ var (
 const (
 	authKey      = "X-RapidAPI-Key"
 	hostKey      = "X-RapidAPI-Host"
-	hostVal      = "api-football-v1.p.rapidapi.com"
+	hostVal      = "api-football-v1.p.rapidapi.com" // default X-RapidAPI-Host
 	apiSportsKey = "x-apisports-key"
 	dateFormat   = "2006-01-02"
 )
@@ -36,6 +33,24 @@ type Client struct {
 	key    string
 	Domain string
 	client HttpClient
+
+	// QuotaHook, if set, is called with the updated quota after every
+	// response that carries rate-limit headers. It may be called from
+	// several goroutines at once. Set it before making requests.
+	QuotaHook func(QuotaStatus)
+
+	// Limiter, if set, throttles requests to the plan's per-minute limit
+	// and enforces a daily budget. Set it before making requests.
+	Limiter *RateLimiter
+
+	provider     Provider
+	rapidAPIHost string
+	cache        Cache
+	cacheTTLs    map[string]time.Duration
+	userAgent    string
+	timeout      time.Duration
+	strictParams bool
+	quota        quotaTracker
 }
 
 // New creates a new Client instance for the API-Football service using the default domain.
@@ -47,44 +62,61 @@ func New(key string, client HttpClient) (*Client, error) {
 // NewWithDomain creates a new Client instance with a custom domain.
 // It returns an error if the API key or domain is missing, or the provided HTTP client is nil.
 func NewWithDomain(key, domain string, client HttpClient) (*Client, error) {
-	if key == "" {
-		return nil, errors.New("missing key")
+	return NewClient(key, WithBaseURL(domain), WithHTTPClient(client))
+}
+
+// get performs an authenticated GET request and returns the response body.
+// If ctx is cancelled or its deadline expires, the returned error wraps
+// ctx.Err(), so callers can test for it with errors.Is.
+func (c *Client) get(ctx context.Context, endpoint string) ([]byte, error) {
+	// Don't spend quota on a request whose caller has already given up.
+	if err := ctx.Err(); err != nil {
+		return nil, err
 	}
-	if domain == "" {
-		return nil, errors.New("missing domain")
+	ttl, finishedOnly := c.cachePolicy(ctx, endpoint)
+	useCache := c.cache != nil && ttl > 0
+	if useCache {
+		if body, ok := c.cache.Get(endpoint); ok {
+			return body, nil
+		}
 	}
-	if client == nil {
-		return nil, errors.New("missing http client")
+
+	if c.Limiter != nil {
+		if err := c.Limiter.Wait(ctx); err != nil {
+			return nil, err
+		}
 	}
-	// Endpoint paths are appended directly to the domain, so it must end with a slash.
-	if !strings.HasSuffix(domain, "/") {
-		domain += "/"
+
+	if c.timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, c.timeout)
+		defer cancel()
 	}
-	return &Client{
-		key:    key,
-		Domain: domain,
-		client: client,
-	}, nil
-}
 
-func (c *Client) get(endpoint string) ([]byte, error) {
-	req, err := http.NewRequestWithContext(context.Background(), http.MethodGet, endpoint, http.NoBody)
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, endpoint, http.NoBody)
 	if err != nil {
 		return nil, err
 	}
-	req.Header.Add(authKey, c.key)
-	req.Header.Add(hostKey, hostVal)
-	// The direct v3.football.api-sports.io API authenticates with this header;
-	// RapidAPI ignores it, so it is safe to send in both modes.
-	req.Header.Add(apiSportsKey, c.key)
+	if c.userAgent != "" {
+		req.Header.Set("User-Agent", c.userAgent)
+	}
+	c.setAuthHeaders(req.Header)
 
 	res, err := c.client.Do(req)
 	if err != nil {
+		// HttpClient implementations are not required to wrap the context
+		// error themselves, so make sure it is always reachable.
+		if ctxErr := ctx.Err(); ctxErr != nil && !errors.Is(err, ctxErr) {
+			return nil, fmt.Errorf("%w: %w", ctxErr, err)
+		}
 		return nil, err
 	}
 
 	defer res.Body.Close()
 
+	// Rate-limit headers are sent on error responses (notably 429s) too.
+	c.recordQuota(res)
+
 	// Check for non-2xx status codes
 	if res.StatusCode < http.StatusOK || res.StatusCode >= http.StatusMultipleChoices {
 		var body []byte
@@ -94,40 +126,19 @@ func (c *Client) get(endpoint string) ([]byte, error) {
 		return nil, fmt.Errorf("API request failed with status %d: %s", res.StatusCode, string(body))
 	}
 
-	return io.ReadAll(res.Body)
-}
-
-func (c *Client) formatDate(t time.Time) string {
-	return t.Format(dateFormat)
-}
-
-// paramString formats a query parameter value. JSON-decoded numbers arrive as
-// float64 and must render as plain integers when whole (1581037), never in
-// scientific notation (1.581037e+06), which the API rejects.
-func paramString(value any) string {
-	switch v := value.(type) {
-	case float64:
-		if v == float64(int64(v)) {
-			return strconv.FormatInt(int64(v), 10)
-		}
-		return strconv.FormatFloat(v, 'f', -1, 64)
-	default:
-		return fmt.Sprintf("%v", v)
-	}
-}
-
-// buildURL constructs the URL with dynamic parameters using net/url
-func (c *Client) buildURL(endpoint string, params map[string]any) string {
-	u, err := url.Parse(endpoint)
+	body, err := io.ReadAll(res.Body)
 	if err != nil {
-		return endpoint
+		return nil, err
 	}
 
-	values := url.Values{}
-	for key, value := range params {
-		values.Add(key, paramString(value))
+	// API-Football reports rejected parameters, exhausted quotas and auth
+	// failures inside a 200 OK body.
+	if err := checkAPIErrors(body); err != nil {
+		return nil, err
 	}
 
-	u.RawQuery = values.Encode()
-	return u.String()
+	if useCache && (!finishedOnly || allFixturesFinished(body)) {
+		c.cache.Set(endpoint, body, ttl)
+	}
+	return body, nil
 }
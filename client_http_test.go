@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"io"
 	"net/http"
+	"sync"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -11,21 +12,64 @@ import (
 	client "github.com/0ffsideCompass/api-football-go-client"
 )
 
-// MockHTTPClient is a mock implementation of the HttpClient interface
+// MockHTTPClient is a mock implementation of the HttpClient interface. It
+// returns Response and Err, or, if Respond is set, whatever Respond builds
+// for each request. It counts and records the requests it receives, and is
+// safe for concurrent use.
 type MockHTTPClient struct {
 	Response    *http.Response
 	Err         error
 	LastRequest *http.Request
+	// Respond, if set, answers each request instead of Response and Err.
+	Respond func(req *http.Request) (*http.Response, error)
+	// Calls is the number of requests received, and URLs their URLs.
+	Calls int
+	URLs  []string
+
+	mu sync.Mutex
 }
 
 func (m *MockHTTPClient) Do(req *http.Request) (*http.Response, error) {
+	m.mu.Lock()
 	m.LastRequest = req
+	m.Calls++
+	m.URLs = append(m.URLs, req.URL.String())
+	m.mu.Unlock()
+	if m.Respond != nil {
+		return m.Respond(req)
+	}
 	if m.Err != nil {
 		return nil, m.Err
 	}
 	return m.Response, nil
 }
 
+// mockResponse returns a response with the given status, a copy of header,
+// and body.
+func mockResponse(status int, header http.Header, body string) *http.Response {
+	return &http.Response{
+		StatusCode: status,
+		Header:     header.Clone(),
+		Body:       io.NopCloser(bytes.NewBufferString(body)),
+	}
+}
+
+// respondWith answers every request with a fresh response of status, header
+// and body.
+func respondWith(status int, header http.Header, body string) func(*http.Request) (*http.Response, error) {
+	return func(*http.Request) (*http.Response, error) {
+		return mockResponse(status, header, body), nil
+	}
+}
+
+// respondOK answers each request with a 200 response with the body built by
+// body.
+func respondOK(body func(req *http.Request) string) func(*http.Request) (*http.Response, error) {
+	return func(req *http.Request) (*http.Response, error) {
+		return mockResponse(http.StatusOK, nil, body(req)), nil
+	}
+}
+
 func TestHTTPStatusValidation(t *testing.T) {
 	tests := []struct {
 		name          string
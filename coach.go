@@ -1,6 +1,7 @@
 package client
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 
@@ -9,9 +10,6 @@ import (
 
 const (
 	coachsEndpoint = "coachs"
-
-	// minSearchLength is the minimum length the API accepts for 'search' values.
-	minSearchLength = 3
 )
 
 // Coachs hits the /coachs endpoint.
@@ -26,14 +24,23 @@ const (
 */
 func (c *Client) Coachs(
 	params map[string]any,
+) (*models.Coachs, error) {
+	return c.CoachsContext(context.Background(), params)
+}
+
+// CoachsContext is like Coachs but with a context.
+func (c *Client) CoachsContext(
+	ctx context.Context,
+	params map[string]any,
 ) (*models.Coachs, error) {
 	// Validate the parameters
-	if err := validateCoachsParams(params); err != nil {
+	if err := c.validateParams(coachsEndpoint, params); err != nil {
 		return nil, err
 	}
 
 	endpointURL := fmt.Sprintf("%s%s", c.Domain, coachsEndpoint)
 	body, err := c.get(
+		ctx,
 		c.buildURL(
 			endpointURL,
 			params,
@@ -55,23 +62,7 @@ func (c *Client) Coachs(
 	return &resp, nil
 }
 
-// validateCoachsParams validates the parameters for the Coachs endpoint.
-func validateCoachsParams(params map[string]any) error {
-	// At least one of these parameters must be passed
-	if _, hasTeam := params["team"]; !hasTeam {
-		if _, hasID := params["id"]; !hasID {
-			if _, hasSearch := params["search"]; !hasSearch {
-				return fmt.Errorf("at least one of 'team', 'id', or 'search' must be provided")
-			}
-		}
-	}
-
-	// Validate 'search' parameter
-	if search, ok := params["search"].(string); ok {
-		if len(search) < minSearchLength {
-			return fmt.Errorf("'search' must be at least %d characters long", minSearchLength)
-		}
-	}
-
-	return nil
+// CoachsWith is like CoachsContext but takes typed parameters.
+func (c *Client) CoachsWith(ctx context.Context, params CoachsParams) (*models.Coachs, error) {
+	return c.CoachsContext(ctx, params.Encode())
 }
@@ -0,0 +1,127 @@
+package client_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	client "github.com/0ffsideCompass/api-football-go-client"
+)
+
+// blockUntilDone waits until the request's context is done, mimicking a
+// slow server. Like some third-party clients, it returns a plain error that
+// does not wrap the context error.
+func blockUntilDone(req *http.Request) (*http.Response, error) {
+	<-req.Context().Done()
+	return nil, errors.New("request aborted")
+}
+
+type ctxKey struct{}
+
+func TestContextIsAttachedToRequest(t *testing.T) {
+	tests := []struct {
+		name string
+		call func(ctx context.Context, c *client.Client) error
+	}{
+		{
+			name: "TimezoneContext",
+			call: func(ctx context.Context, c *client.Client) error {
+				_, err := c.TimezoneContext(ctx)
+				return err
+			},
+		},
+		{
+			name: "LeaguesSeasonsContext",
+			call: func(ctx context.Context, c *client.Client) error {
+				_, err := c.LeaguesSeasonsContext(ctx)
+				return err
+			},
+		},
+		{
+			name: "FixtureContext",
+			call: func(ctx context.Context, c *client.Client) error {
+				_, err := c.FixtureContext(ctx, map[string]any{"id": 43})
+				return err
+			},
+		},
+		{
+			name: "FixtureByDateAndLeagueContext",
+			call: func(ctx context.Context, c *client.Client) error {
+				day := time.Date(2023, 8, 1, 0, 0, 0, 0, time.UTC)
+				_, err := c.FixtureByDateAndLeagueContext(ctx, 39, 2023, day, day)
+				return err
+			},
+		},
+		{
+			name: "OddsContext",
+			call: func(ctx context.Context, c *client.Client) error {
+				_, err := c.OddsContext(ctx, map[string]any{"fixture": 326090})
+				return err
+			},
+		},
+		{
+			name: "SearchContext",
+			call: func(ctx context.Context, c *client.Client) error {
+				_, err := c.SearchContext(ctx, "Arsenal", client.Team)
+				return err
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockClient := newMockClient()
+			apiClient, err := client.New("test-key", mockClient)
+			assert.NoError(t, err)
+
+			ctx := context.WithValue(context.Background(), ctxKey{}, "marker")
+			assert.NoError(t, tt.call(ctx, apiClient))
+			assert.NotNil(t, mockClient.LastRequest)
+			assert.Equal(t, "marker", mockClient.LastRequest.Context().Value(ctxKey{}))
+		})
+	}
+}
+
+func TestContextCancellation(t *testing.T) {
+	t.Run("cancelled before the request is sent", func(t *testing.T) {
+		httpClient := &MockHTTPClient{Respond: blockUntilDone}
+		apiClient, err := client.New("test-key", httpClient)
+		assert.NoError(t, err)
+
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+
+		_, err = apiClient.LeaguesContext(ctx, nil)
+		assert.ErrorIs(t, err, context.Canceled)
+		assert.Equal(t, 0, httpClient.Calls)
+	})
+
+	t.Run("cancelled while in flight", func(t *testing.T) {
+		httpClient := &MockHTTPClient{Respond: blockUntilDone}
+		apiClient, err := client.New("test-key", httpClient)
+		assert.NoError(t, err)
+
+		ctx, cancel := context.WithCancel(context.Background())
+		time.AfterFunc(10*time.Millisecond, cancel)
+
+		_, err = apiClient.TimezoneContext(ctx)
+		assert.ErrorIs(t, err, context.Canceled)
+		assert.Contains(t, err.Error(), "error getting timezone")
+		assert.Equal(t, 1, httpClient.Calls)
+	})
+
+	t.Run("deadline exceeded", func(t *testing.T) {
+		apiClient, err := client.New("test-key", &MockHTTPClient{Respond: blockUntilDone})
+		assert.NoError(t, err)
+
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+		defer cancel()
+
+		_, err = apiClient.FixtureContext(ctx, map[string]any{"id": 43})
+		assert.ErrorIs(t, err, context.DeadlineExceeded)
+	})
+}
@@ -1,6 +1,7 @@
 package client
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 
@@ -24,8 +25,22 @@ const (
 func (c *Client) Countries(
 	params map[string]any,
 ) (*models.CountriesResponse, error) {
+	return c.CountriesContext(context.Background(), params)
+}
+
+// CountriesContext is like Countries but with a context.
+func (c *Client) CountriesContext(
+	ctx context.Context,
+	params map[string]any,
+) (*models.CountriesResponse, error) {
+	// Validate the parameters
+	if err := c.validateParams(countriesEndpoint, params); err != nil {
+		return nil, err
+	}
+
 	endpointURL := fmt.Sprintf("%s%s", c.Domain, countriesEndpoint)
 	body, err := c.get(
+		ctx,
 		c.buildURL(
 			endpointURL,
 			params,
@@ -46,3 +61,8 @@ func (c *Client) Countries(
 
 	return &resp, nil
 }
+
+// CountriesWith is like CountriesContext but takes typed parameters.
+func (c *Client) CountriesWith(ctx context.Context, params CountriesParams) (*models.CountriesResponse, error) {
+	return c.CountriesContext(ctx, params.Encode())
+}
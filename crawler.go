@@ -0,0 +1,381 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+
+	"github.com/0ffsideCompass/api-football-go-client/models"
+)
+
+// defaultCrawlConcurrency is the default number of concurrent requests of a
+// SeasonCrawler.
+const defaultCrawlConcurrency = 4
+
+// CrawlSink stores the fixtures a SeasonCrawler fetches. WriteFixture is
+// never called concurrently.
+type CrawlSink interface {
+	WriteFixture(f models.FixtureDetails) error
+}
+
+// CrawlSinkFunc adapts a function to the CrawlSink interface.
+type CrawlSinkFunc func(f models.FixtureDetails) error
+
+// WriteFixture implements CrawlSink.
+func (fn CrawlSinkFunc) WriteFixture(f models.FixtureDetails) error {
+	return fn(f)
+}
+
+// DirSink is a CrawlSink writing each fixture as JSON to its own file,
+// named after the fixture ID, in a directory.
+type DirSink struct {
+	dir string
+}
+
+// NewDirSink returns a DirSink writing to dir, which is created if needed.
+func NewDirSink(dir string) (*DirSink, error) {
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return nil, err
+	}
+	return &DirSink{dir: dir}, nil
+}
+
+// WriteFixture implements CrawlSink. A fixture written again replaces the
+// earlier file.
+func (d *DirSink) WriteFixture(f models.FixtureDetails) error {
+	data, err := json.Marshal(f)
+	if err != nil {
+		return err
+	}
+	return writeFileAtomic(filepath.Join(d.dir, strconv.Itoa(f.Fixture.ID)+".json"), data)
+}
+
+// writeFileAtomic writes data to a temporary file renamed to path, so
+// readers and interrupted writes never leave a partial file.
+func writeFileAtomic(path string, data []byte) error {
+	tmp, err := os.CreateTemp(filepath.Dir(path), ".tmp-*")
+	if err != nil {
+		return err
+	}
+	_, err = tmp.Write(data)
+	if closeErr := tmp.Close(); err == nil {
+		err = closeErr
+	}
+	if err == nil {
+		err = os.Rename(tmp.Name(), path)
+	}
+	if err != nil {
+		_ = os.Remove(tmp.Name())
+	}
+	return err
+}
+
+// SeasonCrawler fetches every fixture of a league season with its events,
+// lineups, statistics and player statistics.
+//
+// Fetching those details with FixturesEvents, FixturesLineups,
+// FixtureStatistics and FixturesPlayer costs four requests per fixture. The
+// crawler instead fetches fixtures by batches of 20 with the 'ids'
+// parameter of the /fixtures endpoint, which returns every detail at once:
+// a 380-fixture season costs 20 requests.
+//
+// Fixtures are written to a CrawlSink as their batch arrives. With a
+// checkpoint file, the IDs of the fixtures written for good are saved after
+// each batch, and a crawl started again with the same file skips them.
+type SeasonCrawler struct {
+	client      *Client
+	league      int
+	season      int
+	sink        CrawlSink
+	concurrency int
+	checkpoint  string
+	progress    func(CrawlStats)
+}
+
+// SeasonCrawlerOption configures a SeasonCrawler.
+type SeasonCrawlerOption func(*SeasonCrawler) error
+
+// WithCrawlConcurrency sets the number of batches fetched concurrently. The
+// default is 4.
+func WithCrawlConcurrency(n int) SeasonCrawlerOption {
+	return func(s *SeasonCrawler) error {
+		if n <= 0 {
+			return errors.New("concurrency must be positive")
+		}
+		s.concurrency = n
+		return nil
+	}
+}
+
+// WithCrawlCheckpoint saves the progress of the crawl to the file at path,
+// and resumes from it if it exists.
+func WithCrawlCheckpoint(path string) SeasonCrawlerOption {
+	return func(s *SeasonCrawler) error {
+		if path == "" {
+			return errors.New("empty checkpoint path")
+		}
+		s.checkpoint = path
+		return nil
+	}
+}
+
+// WithCrawlProgress calls fn after each batch with the progress so far. It
+// is never called concurrently.
+func WithCrawlProgress(fn func(CrawlStats)) SeasonCrawlerOption {
+	return func(s *SeasonCrawler) error {
+		s.progress = fn
+		return nil
+	}
+}
+
+// NewSeasonCrawler returns a SeasonCrawler for a league season, writing to
+// sink.
+func (c *Client) NewSeasonCrawler(league, season int, sink CrawlSink, opts ...SeasonCrawlerOption) (*SeasonCrawler, error) {
+	if league <= 0 || season <= 0 {
+		return nil, errors.New("league and season must be positive")
+	}
+	if sink == nil {
+		return nil, errors.New("no crawl sink")
+	}
+	s := &SeasonCrawler{
+		client:      c,
+		league:      league,
+		season:      season,
+		sink:        sink,
+		concurrency: defaultCrawlConcurrency,
+	}
+	for _, opt := range opts {
+		if err := opt(s); err != nil {
+			return nil, fmt.Errorf("error creating season crawler: %w", err)
+		}
+	}
+	return s, nil
+}
+
+// CrawlPlan is the work left in a crawl.
+type CrawlPlan struct {
+	// Fixtures holds every fixture of the season, as listed by /fixtures.
+	Fixtures []models.Fixture
+	// Batches holds the IDs of the fixtures to fetch with their details, by
+	// request: the fixtures with a result (see models.FixtureStatus) and
+	// the abandoned ones, which have details up to the abandonment.
+	Batches [][]int
+	// Done is the number of fixtures already written by an earlier crawl.
+	Done int
+	// Unfinished is the number of fixtures not played yet. They are written
+	// without details, and crawled again until they have a result.
+	Unfinished int
+}
+
+// Requests returns the number of requests the plan needs.
+func (p *CrawlPlan) Requests() int {
+	return len(p.Batches)
+}
+
+// CrawlStats reports the progress of a crawl.
+type CrawlStats struct {
+	// Fixtures is the number of fixtures in the season.
+	Fixtures int
+	// Skipped is the number of fixtures written by an earlier crawl.
+	Skipped int
+	// Crawled is the number of fixtures written with their details.
+	Crawled int
+	// Unfinished is the number of fixtures written without details, as they
+	// have not been played yet.
+	Unfinished int
+	// Missing lists the IDs of fixtures the API did not return when fetched
+	// by ID. They are retried by the next crawl.
+	Missing []int
+	// Requests is the number of requests made, including the listing and
+	// the requests that failed.
+	Requests int
+}
+
+// crawlCheckpoint is the content of a checkpoint file.
+type crawlCheckpoint struct {
+	League int   `json:"league"`
+	Season int   `json:"season"`
+	Done   []int `json:"done"`
+}
+
+// loadCheckpoint returns the IDs of the fixtures written by earlier crawls.
+func (s *SeasonCrawler) loadCheckpoint() (map[int]bool, error) {
+	done := make(map[int]bool)
+	if s.checkpoint == "" {
+		return done, nil
+	}
+	data, err := os.ReadFile(s.checkpoint)
+	if errors.Is(err, os.ErrNotExist) {
+		return done, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("error reading checkpoint: %w", err)
+	}
+	var cp crawlCheckpoint
+	if err := json.Unmarshal(data, &cp); err != nil {
+		return nil, fmt.Errorf("error reading checkpoint: %w", err)
+	}
+	if cp.League != s.league || cp.Season != s.season {
+		return nil, fmt.Errorf("checkpoint %s is for league %d season %d", s.checkpoint, cp.League, cp.Season)
+	}
+	for _, id := range cp.Done {
+		done[id] = true
+	}
+	return done, nil
+}
+
+// saveCheckpoint records the IDs of the fixtures written so far.
+func (s *SeasonCrawler) saveCheckpoint(done map[int]bool) error {
+	if s.checkpoint == "" {
+		return nil
+	}
+	cp := crawlCheckpoint{League: s.league, Season: s.season, Done: make([]int, 0, len(done))}
+	for id := range done {
+		cp.Done = append(cp.Done, id)
+	}
+	sort.Ints(cp.Done)
+	data, err := json.Marshal(cp)
+	if err != nil {
+		return err
+	}
+	if err := writeFileAtomic(s.checkpoint, data); err != nil {
+		return fmt.Errorf("error saving checkpoint: %w", err)
+	}
+	return nil
+}
+
+// Plan lists the fixtures of the season, at the cost of one request, and
+// returns the batches left to fetch given the checkpoint.
+func (s *SeasonCrawler) Plan(ctx context.Context) (*CrawlPlan, error) {
+	done, err := s.loadCheckpoint()
+	if err != nil {
+		return nil, err
+	}
+	resp, err := s.client.FixtureContext(ctx, map[string]any{"league": s.league, "season": s.season})
+	if err != nil {
+		return nil, fmt.Errorf("error listing season fixtures: %w", err)
+	}
+
+	plan := &CrawlPlan{Fixtures: make([]models.Fixture, len(resp.Response))}
+	var pending []int
+	for i, f := range resp.Response {
+		plan.Fixtures[i] = f.Summary()
+		id := f.Fixture.ID
+		switch status := f.Fixture.Status.Short; {
+		case done[id]:
+			plan.Done++
+		case hasDetails(status):
+			pending = append(pending, id)
+		case status.IsCancelled():
+		default:
+			plan.Unfinished++
+		}
+	}
+	sort.Ints(pending)
+	plan.Batches = chunkIDs(pending)
+	return plan, nil
+}
+
+// hasDetails reports whether a fixture with the given status has details to
+// fetch: it has a result, or was abandoned after kick-off.
+func hasDetails(status models.FixtureStatus) bool {
+	return status.HasResult() || status == models.StatusAbandoned
+}
+
+// Run plans the crawl and executes the plan.
+func (s *SeasonCrawler) Run(ctx context.Context) (CrawlStats, error) {
+	plan, err := s.Plan(ctx)
+	if err != nil {
+		return CrawlStats{Requests: 1}, err
+	}
+	stats, err := s.Execute(ctx, plan)
+	stats.Requests++
+	return stats, err
+}
+
+// Execute fetches the batches of plan, writes their fixtures to the sink and
+// saves the checkpoint after each batch. Only fixtures returned with a
+// result are marked done: abandoned fixtures are written with their details
+// but fetched again by the next crawl, as they may be resumed. Cancelled
+// fixtures are written as listed and marked done, as they never get details;
+// other unfinished fixtures are written as listed but not marked done.
+//
+// The first error stops the crawl: batches already fetched stay written and
+// checkpointed, so running the crawl again resumes from there. When the
+// daily budget of the client's Limiter runs out, the error matches
+// ErrQuotaExceeded.
+func (s *SeasonCrawler) Execute(ctx context.Context, plan *CrawlPlan) (CrawlStats, error) {
+	done, err := s.loadCheckpoint()
+	if err != nil {
+		return CrawlStats{}, err
+	}
+	stats := CrawlStats{Fixtures: len(plan.Fixtures), Skipped: plan.Done}
+
+	changed := false
+	for _, f := range plan.Fixtures {
+		status := f.Fixture.Status.Short
+		if done[f.Fixture.ID] || hasDetails(status) {
+			continue
+		}
+		details := models.FixtureDetails{Fixture: f.Fixture, League: f.League, Teams: f.Teams, Goals: f.Goals, Score: f.Score}
+		if err := s.sink.WriteFixture(details); err != nil {
+			return stats, fmt.Errorf("error writing fixture %d: %w", f.Fixture.ID, err)
+		}
+		if status.IsCancelled() {
+			done[f.Fixture.ID] = true
+			changed = true
+		} else {
+			stats.Unfinished++
+		}
+	}
+	if changed {
+		if err := s.saveCheckpoint(done); err != nil {
+			return stats, err
+		}
+	}
+
+	err = s.client.fetchFixtureBatches(ctx, plan.Batches, s.concurrency, func(batch []int, fixtures []models.FixtureDetails, err error) error {
+		stats.Requests++
+		if err != nil {
+			return err
+		}
+		return s.store(batch, fixtures, done, &stats)
+	})
+	sort.Ints(stats.Missing)
+	return stats, err
+}
+
+// store writes the fixtures of a batch, marks those with a result done and
+// saves the checkpoint.
+func (s *SeasonCrawler) store(batch []int, fixtures []models.FixtureDetails, done map[int]bool, stats *CrawlStats) error {
+	returned := make(map[int]bool, len(fixtures))
+	for _, f := range fixtures {
+		if err := s.sink.WriteFixture(f); err != nil {
+			return fmt.Errorf("error writing fixture %d: %w", f.Fixture.ID, err)
+		}
+		returned[f.Fixture.ID] = true
+		if f.Fixture.Status.Short.HasResult() {
+			done[f.Fixture.ID] = true
+		}
+		stats.Crawled++
+	}
+	for _, id := range batch {
+		if !returned[id] {
+			stats.Missing = append(stats.Missing, id)
+		}
+	}
+	if err := s.saveCheckpoint(done); err != nil {
+		return err
+	}
+	if s.progress != nil {
+		progress := *stats
+		progress.Missing = append([]int(nil), stats.Missing...)
+		s.progress(progress)
+	}
+	return nil
+}
@@ -0,0 +1,226 @@
+package client_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	client "github.com/0ffsideCompass/api-football-go-client"
+	"github.com/0ffsideCompass/api-football-go-client/models"
+)
+
+// seasonAPI serves a season of 44 finished fixtures (IDs 1 to 44), one
+// abandoned (45), one not started (46) and one cancelled (47). Fixtures
+// fetched by ID carry a goal event; those in omit are left out.
+func seasonAPI(t *testing.T, omit ...int) (*client.Client, *MockHTTPClient) {
+	t.Helper()
+	status := func(id int) string {
+		switch id {
+		case 45:
+			return "ABD"
+		case 46:
+			return "NS"
+		case 47:
+			return "CANC"
+		default:
+			return "FT"
+		}
+	}
+	httpClient := &MockHTTPClient{Respond: respondOK(func(req *http.Request) string {
+		query := req.URL.Query()
+		var fixtures []string
+		if ids := query.Get("ids"); ids != "" {
+			for _, s := range strings.Split(ids, "-") {
+				id, _ := strconv.Atoi(s)
+				if !containsInt(omit, id) {
+					fixtures = append(fixtures, liveFixture(id, status(id), 90, 1, 0, liveEvent(10, 33, "Goal", "Normal Goal", "Rashford")))
+				}
+			}
+		} else {
+			for id := 1; id <= 47; id++ {
+				fixtures = append(fixtures, liveFixture(id, status(id), 90, 1, 0))
+			}
+		}
+		return liveBody(fixtures...)
+	})}
+	apiClient, err := client.New("test-key", httpClient)
+	assert.NoError(t, err)
+	return apiClient, httpClient
+}
+
+func containsInt(ids []int, id int) bool {
+	for _, i := range ids {
+		if i == id {
+			return true
+		}
+	}
+	return false
+}
+
+// memorySink records the fixtures written, keyed by ID.
+type memorySink struct {
+	mu       sync.Mutex
+	fixtures map[int]models.FixtureDetails
+	failOn   int
+}
+
+func (m *memorySink) WriteFixture(f models.FixtureDetails) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if f.Fixture.ID == m.failOn {
+		return errors.New("disk full")
+	}
+	if m.fixtures == nil {
+		m.fixtures = make(map[int]models.FixtureDetails)
+	}
+	m.fixtures[f.Fixture.ID] = f
+	return nil
+}
+
+func TestSeasonCrawler(t *testing.T) {
+	apiClient, httpClient := seasonAPI(t)
+	checkpoint := filepath.Join(t.TempDir(), "checkpoint.json")
+	sink := &memorySink{}
+	var progress []client.CrawlStats
+	crawler, err := apiClient.NewSeasonCrawler(39, 2023, sink,
+		client.WithCrawlCheckpoint(checkpoint),
+		client.WithCrawlConcurrency(2),
+		client.WithCrawlProgress(func(s client.CrawlStats) { progress = append(progress, s) }),
+	)
+	assert.NoError(t, err)
+
+	plan, err := crawler.Plan(context.Background())
+	assert.NoError(t, err)
+	assert.Len(t, plan.Fixtures, 47)
+	assert.Equal(t, 3, plan.Requests())
+	assert.Equal(t, []int{41, 42, 43, 44, 45}, plan.Batches[2])
+	assert.Equal(t, 1, plan.Unfinished)
+
+	stats, err := crawler.Execute(context.Background(), plan)
+	assert.NoError(t, err)
+	assert.Equal(t, client.CrawlStats{Fixtures: 47, Crawled: 45, Unfinished: 1, Requests: 3}, stats)
+	assert.Len(t, progress, 3)
+	assert.Len(t, sink.fixtures, 47)
+	assert.Len(t, sink.fixtures[12].Events, 1, "details come with the batch")
+	assert.Len(t, sink.fixtures[45].Events, 1, "abandoned fixtures have details")
+	assert.Empty(t, sink.fixtures[46].Events)
+	assert.Len(t, httpClient.URLs, 4)
+	assert.Equal(t, "https://v3.football.api-sports.io/fixtures?league=39&season=2023", httpClient.URLs[0])
+	assert.Contains(t, httpClient.URLs, "https://v3.football.api-sports.io/fixtures?ids=41-42-43-44-45")
+
+	// A second crawl lists the season again and only fetches the abandoned
+	// fixture, which may have been resumed.
+	stats, err = crawler.Run(context.Background())
+	assert.NoError(t, err)
+	assert.Equal(t, client.CrawlStats{Fixtures: 47, Skipped: 45, Crawled: 1, Unfinished: 1, Requests: 2}, stats)
+	assert.Len(t, httpClient.URLs, 6)
+	assert.Equal(t, "https://v3.football.api-sports.io/fixtures?ids=45", httpClient.URLs[5])
+
+	other, err := apiClient.NewSeasonCrawler(39, 2022, sink, client.WithCrawlCheckpoint(checkpoint))
+	assert.NoError(t, err)
+	_, err = other.Run(context.Background())
+	assert.EqualError(t, err, "checkpoint "+checkpoint+" is for league 39 season 2023")
+}
+
+func TestSeasonCrawlerResumes(t *testing.T) {
+	apiClient, httpClient := seasonAPI(t, 7)
+	checkpoint := filepath.Join(t.TempDir(), "checkpoint.json")
+	sink := &memorySink{failOn: 25}
+	crawler, err := apiClient.NewSeasonCrawler(39, 2023, sink,
+		client.WithCrawlCheckpoint(checkpoint),
+		client.WithCrawlConcurrency(1),
+	)
+	assert.NoError(t, err)
+
+	stats, err := crawler.Run(context.Background())
+	assert.EqualError(t, err, "error writing fixture 25: disk full")
+	assert.Equal(t, []int{7}, stats.Missing)
+	assert.Equal(t, 3, stats.Requests, "the crawl stops at the failing batch")
+
+	data, err := os.ReadFile(checkpoint)
+	assert.NoError(t, err)
+	var saved struct{ Done []int }
+	assert.NoError(t, json.Unmarshal(data, &saved))
+	assert.Len(t, saved.Done, 20, "the first batch less the missing fixture, and the cancelled one")
+	assert.NotContains(t, saved.Done, 7)
+
+	sink.failOn = 0
+	plan, err := crawler.Plan(context.Background())
+	assert.NoError(t, err)
+	assert.Equal(t, [][]int{
+		{7, 21, 22, 23, 24, 25, 26, 27, 28, 29, 30, 31, 32, 33, 34, 35, 36, 37, 38, 39},
+		{40, 41, 42, 43, 44, 45},
+	}, plan.Batches)
+	assert.Equal(t, 20, plan.Done)
+
+	urls := len(httpClient.URLs)
+	stats, err = crawler.Execute(context.Background(), plan)
+	assert.NoError(t, err)
+	assert.Equal(t, 2, len(httpClient.URLs)-urls)
+	assert.Equal(t, 25, stats.Crawled)
+	assert.Equal(t, []int{7}, stats.Missing)
+}
+
+func TestSeasonCrawlerCountsFailedRequests(t *testing.T) {
+	apiClient, httpClient := seasonAPI(t)
+	respond := httpClient.Respond
+	httpClient.Respond = func(req *http.Request) (*http.Response, error) {
+		if strings.HasPrefix(req.URL.Query().Get("ids"), "21-") {
+			return nil, errors.New("connection reset")
+		}
+		return respond(req)
+	}
+	crawler, err := apiClient.NewSeasonCrawler(39, 2023, &memorySink{}, client.WithCrawlConcurrency(1))
+	assert.NoError(t, err)
+
+	stats, err := crawler.Run(context.Background())
+	assert.ErrorContains(t, err, "connection reset")
+	assert.Equal(t, 20, stats.Crawled)
+	assert.Equal(t, 3, stats.Requests, "the listing, the first batch and the failed one")
+}
+
+func TestSeasonCrawlerErrors(t *testing.T) {
+	apiClient, _ := seasonAPI(t)
+	sink := &memorySink{}
+	_, err := apiClient.NewSeasonCrawler(0, 2023, sink)
+	assert.EqualError(t, err, "league and season must be positive")
+	_, err = apiClient.NewSeasonCrawler(39, 2023, nil)
+	assert.EqualError(t, err, "no crawl sink")
+	_, err = apiClient.NewSeasonCrawler(39, 2023, sink, client.WithCrawlConcurrency(0))
+	assert.EqualError(t, err, "error creating season crawler: concurrency must be positive")
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	crawler, err := apiClient.NewSeasonCrawler(39, 2023, sink)
+	assert.NoError(t, err)
+	_, err = crawler.Run(ctx)
+	assert.ErrorIs(t, err, context.Canceled)
+}
+
+func TestDirSink(t *testing.T) {
+	dir := filepath.Join(t.TempDir(), "fixtures")
+	sink, err := client.NewDirSink(dir)
+	assert.NoError(t, err)
+	var f models.FixtureDetails
+	f.Fixture.ID = 1035037
+	f.Goals.Home = 2
+	assert.NoError(t, sink.WriteFixture(f))
+
+	data, err := os.ReadFile(filepath.Join(dir, "1035037.json"))
+	assert.NoError(t, err)
+	var got models.FixtureDetails
+	assert.NoError(t, json.Unmarshal(data, &got))
+	assert.Equal(t, 2, got.Goals.Home)
+	entries, err := os.ReadDir(dir)
+	assert.NoError(t, err)
+	assert.Len(t, entries, 1, "no temporary files are left behind")
+}
@@ -13,10 +13,25 @@
 //		log.Fatal(err)
 //	}
 //
+// NewClient takes functional options instead, and falls back to
+// http.DefaultClient when no HTTP client is given:
+//
+//	cli, err := client.NewClient(apiKey,
+//		client.WithTimeout(10*time.Second),
+//		client.WithUserAgent("my-app/1.0"),
+//		client.WithRateLimiter(client.NewRateLimiter(300, 7500)),
+//	)
+//
+// New and NewWithDomain are shorthands for NewClient with WithHTTPClient and
+// WithBaseURL.
+//
 // By default requests go to the direct API at https://v3.football.api-sports.io/,
 // authenticated with the x-apisports-key header. To use the RapidAPI gateway
-// instead, pass its base URL to NewWithDomain; the client sends the RapidAPI
-// headers as well, so both providers work without further configuration.
+// instead, pass its base URL to NewWithDomain (or WithBaseURL): hosts under
+// rapidapi.com switch the client to the RapidAPI provider, which sends
+// X-RapidAPI-Key and X-RapidAPI-Host instead. Only the headers of the
+// selected provider are sent. WithProvider and WithRapidAPIHost override the
+// detection, for example behind a proxy.
 //
 // # Calling endpoints
 //
@@ -29,10 +44,88 @@
 //		"date":   "2023-08-12",
 //	})
 //
+// Response models are built from shared named types — models.Fixture,
+// models.TeamRef, models.LeagueRef, models.StandingRow, models.Lineup,
+// models.Event and so on — so code written against a fixture or a team works
+// with every endpoint that returns one. Every response embeds a
+// models.Envelope, which holds the echoed Parameters, the decoded Errors,
+// Results and Paging, and provides IsEmpty and Err.
+//
+// Ratings, "45%" percentages and averages arrive as strings. The models
+// that hold them provide numeric accessors, such as MatchStatistics.Rating,
+// MinuteStat.Percent, HomeAwayString.HomeValue and Prediction.Percents, and
+// FlexString has Int, Float and Percent. They report ok == false for a
+// missing value, so a null is never mistaken for zero. Odds values provide
+// Price, which parses the odd exactly into a models.Decimal.
+//
+// Parameter values are normalized before sending: keys are sorted, whole
+// float64 numbers (as decoded from JSON) render as integers, time.Time
+// values as YYYY-MM-DD dates, and []int as the API's "id-id-id" lists.
+// NewCanonicalRequest exposes the same normalization, so equal queries can
+// be recognized however their parameters were built.
+//
+// Each endpoint method also has a With variant taking a typed parameter
+// struct instead of a map, with time.Time dates and typed enums such as
+// models.FixtureStatus. Zero-valued fields are left out of the query:
+//
+//	fixtures, err := cli.FixtureWith(ctx, client.FixturesParams{
+//		League: 39,
+//		Season: 2023,
+//		Status: models.StatusFilter{models.StatusFinished},
+//	})
+//
+// The structs' Encode methods return the equivalent map.
+//
+// Fixture statuses ("NS", "1H", "FT", ...) are typed as models.FixtureStatus,
+// with predicates for the fixture lifecycle: IsScheduled, IsLive, IsFinished,
+// IsCancelled, HasResult and IsFinal. The status parameter takes a
+// models.StatusFilter, which renders as the API's "NS-PST-FT" form, and
+// unknown statuses are rejected before the request is sent.
+//
 // Each method's documentation lists the parameters the endpoint accepts,
-// including which ones are required. Required parameters are validated before
-// the request is sent. Endpoints that take no parameters (for example
-// Timezone and LeaguesSeasons) take no arguments.
+// including which ones are required. Parameters are checked against the
+// endpoint's rules before the request is sent — required and co-required
+// parameters, integer IDs, 4-digit seasons, YYYY-MM-DD dates, minimum search
+// lengths, at most 20 fixture ids — and a *ValidationError listing every
+// violation is returned. It matches ErrInvalidParameter. Parameters the
+// endpoint does not document are forwarded as-is, unless the client was
+// created with WithStrictParams, which rejects them and suggests the closest
+// known name. Endpoints that take no parameters (for example Timezone and
+// LeaguesSeasons) take no arguments.
+//
+// # Pagination
+//
+// Players, PlayersProfiles, Odds and OddsMapping are paginated. PlayersAll,
+// PlayersProfilesAll, OddsAll and OddsMappingAll return a Pager that walks
+// every page, fetching the next one only when needed:
+//
+//	pager := cli.OddsAll(ctx, map[string]any{"league": 39, "season": 2023})
+//	for pager.Next() {
+//		odds := pager.Item()
+//		// ...
+//	}
+//	if err := pager.Err(); err != nil {
+//		// ...
+//	}
+//
+// With Go 1.23 or later, Pager.All returns an iterator for use with range.
+//
+// # Contexts
+//
+// Every endpoint method has a Context variant (FixtureContext,
+// TimezoneContext, and so on) that takes a context.Context as its first
+// argument. The context is attached to the outgoing HTTP request, so
+// cancelling it or letting its deadline expire aborts the call; the returned
+// error then wraps context.Canceled or context.DeadlineExceeded:
+//
+//	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+//	defer cancel()
+//	fixtures, err := cli.FixtureContext(ctx, map[string]any{"live": "all"})
+//	if errors.Is(err, context.DeadlineExceeded) {
+//		// ...
+//	}
+//
+// The methods without the suffix use context.Background().
 //
 // # Error handling
 //
@@ -40,13 +133,101 @@
 // with the response body included in the message) and for undecodable
 // responses.
 //
-// Be aware that API-Football reports some failures — invalid parameters,
-// exceeded quotas — inside the body of a 200 OK response. The client does not
-// currently turn those into errors; check the Errors field on the response
-// model to distinguish an empty result from a rejected request.
+// API-Football also reports some failures — invalid parameters, exceeded
+// quotas, bad keys — inside the body of a 200 OK response. The client
+// inspects the "errors" field of every response and returns those as an
+// *APIError, which matches ErrQuotaExceeded, ErrAuth or ErrInvalidParameter
+// with errors.Is:
+//
+//	_, err := cli.Standings(map[string]any{"league": 39, "season": 23})
+//	var apiErr *client.APIError
+//	if errors.As(err, &apiErr) {
+//		fmt.Println(apiErr.Errors["season"])
+//	}
+//
+// # Live matches
+//
+// NewLiveWatcher returns a LiveWatcher, which polls the live fixtures and
+// reports what changed between polls as LiveEvents: kickoffs, goals, cards,
+// substitutions, VAR decisions, status, score and elapsed-minute changes, and
+// full time. Match events the API re-sends are reported once, and goals it
+// withdraws are reported as LiveGoalCancelled:
+//
+//	watcher, err := cli.NewLiveWatcher(15*time.Second, client.WithLiveLeagues(39))
+//	if err != nil {
+//		// ...
+//	}
+//	go watcher.Run(ctx)
+//	for ev := range watcher.Events() {
+//		// ...
+//	}
+//
+// NewOddsTracker returns an OddsTracker, which samples the in-play odds and
+// reports OddsMovements: price moves with their Delta, suspended and resumed
+// selections or fixtures, and main-line changes. The history of every
+// selection is kept in memory, and can be read with History and Series or
+// exported with WriteCSV.
+//
+// # Bulk crawls
+//
+// NewSeasonCrawler returns a SeasonCrawler, which fetches every fixture of a
+// league season with its events, lineups and statistics. Fixtures are
+// fetched 20 at a time through the 'ids' parameter, so a season costs a few
+// dozen requests rather than thousands. They are written to a CrawlSink,
+// such as a DirSink, and a checkpoint file lets an interrupted crawl resume
+// where it stopped:
+//
+//	sink, err := client.NewDirSink("premier-league-2023")
+//	// ...
+//	crawler, err := cli.NewSeasonCrawler(39, 2023, sink,
+//		client.WithCrawlCheckpoint("premier-league-2023/checkpoint.json"),
+//	)
+//	// ...
+//	stats, err := crawler.Run(ctx)
+//
+// # Caching
+//
+// WithCache stores responses that rarely change in a Cache, keyed by their
+// canonical request URL (see CanonicalRequest), so repeated calls do not
+// cost quota. MemoryCache (an LRU) and FileCache (one file per response,
+// surviving restarts) are provided. How long each endpoint is cached follows
+// a built-in table — for example 30 days for Timezone, one day for Leagues,
+// and never for OddsLive. Fixture responses are cached for 30 days, but only
+// for queries by id or ids, or by a date or from/to range that has ended, and
+// only once all their fixtures are finished; queries with last, next, live or
+// status, or by team, league or season alone, are not cached. WithCacheTTL
+// overrides an endpoint's TTL, and ContextWithCacheTTL a single call's (the
+// finished-fixture check still applies):
+//
+//	cli, err := client.NewClient(apiKey,
+//		client.WithCache(client.NewMemoryCache(1000)),
+//		client.WithCacheTTL("fixtures/lineups", time.Hour),
+//	)
 //
 // # Retries and rate limits
 //
+// The client records the rate-limit headers of every response. Quota returns
+// the latest snapshot (daily and per-minute limits and remaining requests),
+// and QuotaHook, if set, is called each time it changes:
+//
+//	cli.QuotaHook = func(q client.QuotaStatus) {
+//		if q.DailyRemaining < 100 {
+//			log.Printf("only %d requests left today", q.DailyRemaining)
+//		}
+//	}
+//
+// To stay within the plan's limits, set Limiter to a RateLimiter. It blocks
+// each call until the per-minute limit allows it (honouring the call's
+// context) and fails fast with a *BudgetExhaustedError, which matches
+// ErrQuotaExceeded, once the daily budget is spent:
+//
+//	cli.Limiter = client.NewRateLimiter(300, 7500)
+//
+// A per-minute limit of zero is learned from the rate-limit headers. The
+// Limiter and the cache apply to every request the client sends, including
+// those of Pagers, LiveWatchers, OddsTrackers, SeasonCrawlers and
+// FixturesByIDs.
+//
 // The client ships without retry logic by design: inject a retrying HTTP
 // client (such as hashicorp/go-retryablehttp's StandardClient) if you need
 // it. Note that API-Football enforces per-minute and per-day quotas and every
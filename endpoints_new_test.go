@@ -9,7 +9,6 @@ import (
 	"github.com/stretchr/testify/assert"
 
 	client "github.com/0ffsideCompass/api-football-go-client"
-	"github.com/0ffsideCompass/api-football-go-client/models"
 )
 
 func newTestClient(t *testing.T, body string) *client.Client {
@@ -329,8 +328,34 @@ func TestPredictionsUnmarshal(t *testing.T) {
 	assert.Equal(t, "45%", item.Predictions.Percent.Home)
 	assert.Equal(t, 13, item.Teams.Home.League.Fixtures.Played.Total)
 	assert.Equal(t, "1.2", item.Teams.Home.League.Goals.For.Average.Home)
-	assert.Equal(t, models.FlexString("0.6"), item.Teams.Home.Last5.Goals.For.Average)
 	assert.Equal(t, "75%", item.Comparison.PoissonDistribution.Home)
+
+	home, draw, away, ok := item.Percents()
+	assert.True(t, ok)
+	assert.Equal(t, []float64{45, 45, 10}, []float64{home, draw, away})
+	homeGoals, awayGoals, ok := item.PredictedGoals()
+	assert.True(t, ok)
+	assert.Equal(t, []float64{-2.5, -1.5}, []float64{homeGoals, awayGoals})
+	att, ok := item.Teams.Home.Last5Att()
+	assert.True(t, ok)
+	assert.Equal(t, 60.0, att)
+	def, ok := item.Teams.Home.Last5Def()
+	assert.True(t, ok, "0% is a value, not a missing one")
+	assert.Equal(t, 0.0, def)
+	// Averages read the same whether the API sent a number or a string.
+	last5, ok := item.Teams.Home.Last5.Goals.For.Average.Float()
+	assert.True(t, ok)
+	assert.Equal(t, 0.6, last5)
+	league, ok := item.Teams.Home.League.Goals.For.Average.HomeValue()
+	assert.True(t, ok)
+	assert.Equal(t, 1.2, league)
+	poisson, ok := item.Comparison.PoissonDistribution.HomeValue()
+	assert.True(t, ok)
+	assert.Equal(t, 75.0, poisson)
+	_, ok = item.Teams.Home.League.Biggest.Wins.HomeValue()
+	assert.False(t, ok, "a score is not a number")
+	_, ok = item.Comparison.Form.TotalValue()
+	assert.False(t, ok, "missing")
 	assert.Len(t, item.H2H, 1)
 	assert.Equal(t, 198706, item.H2H[0].Fixture.ID)
 }
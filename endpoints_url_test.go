@@ -70,7 +70,7 @@ func TestRequestURLs(t *testing.T) {
 				_, err := c.FixtureByDateAndLeague(39, 2023, from, to)
 				return err
 			},
-			expectedURL: "https://v3.football.api-sports.io/fixtures?league=39&season=2023&from=2023-08-01&to=2023-08-31",
+			expectedURL: "https://v3.football.api-sports.io/fixtures?from=2023-08-01&league=39&season=2023&to=2023-08-31",
 		},
 		{
 			name: "Search teams uses the search query parameter",
@@ -112,17 +112,102 @@ func TestRequestURLs(t *testing.T) {
 }
 
 func TestRequestHeaders(t *testing.T) {
-	mockClient := newMockClient()
-	apiClient, err := client.New("test-api-key", mockClient)
-	assert.NoError(t, err)
+	tests := []struct {
+		name             string
+		newClient        func(c client.HttpClient) (*client.Client, error)
+		expectedProvider client.Provider
+		expectedHeaders  map[string]string
+	}{
+		{
+			name: "direct API by default",
+			newClient: func(c client.HttpClient) (*client.Client, error) {
+				return client.New("test-api-key", c)
+			},
+			expectedProvider: client.Direct,
+			expectedHeaders: map[string]string{
+				"x-apisports-key": "test-api-key",
+				"X-RapidAPI-Key":  "",
+				"X-RapidAPI-Host": "",
+			},
+		},
+		{
+			name: "RapidAPI detected from the domain",
+			newClient: func(c client.HttpClient) (*client.Client, error) {
+				return client.NewWithDomain("test-api-key", "https://api-football-v1.p.rapidapi.com/v3/", c)
+			},
+			expectedProvider: client.RapidAPI,
+			expectedHeaders: map[string]string{
+				"x-apisports-key": "",
+				"X-RapidAPI-Key":  "test-api-key",
+				"X-RapidAPI-Host": "api-football-v1.p.rapidapi.com",
+			},
+		},
+		{
+			name: "RapidAPI host follows other listings",
+			newClient: func(c client.HttpClient) (*client.Client, error) {
+				return client.NewWithDomain("test-api-key", "https://api-football-beta.p.rapidapi.com/", c)
+			},
+			expectedProvider: client.RapidAPI,
+			expectedHeaders: map[string]string{
+				"X-RapidAPI-Key":  "test-api-key",
+				"X-RapidAPI-Host": "api-football-beta.p.rapidapi.com",
+			},
+		},
+		{
+			name: "explicit RapidAPI provider behind a proxy",
+			newClient: func(c client.HttpClient) (*client.Client, error) {
+				return client.NewClient("test-api-key",
+					client.WithHTTPClient(c),
+					client.WithBaseURL("https://proxy.internal/football/"),
+					client.WithProvider(client.RapidAPI),
+					client.WithRapidAPIHost("custom.p.rapidapi.com"),
+				)
+			},
+			expectedProvider: client.RapidAPI,
+			expectedHeaders: map[string]string{
+				"x-apisports-key": "",
+				"X-RapidAPI-Key":  "test-api-key",
+				"X-RapidAPI-Host": "custom.p.rapidapi.com",
+			},
+		},
+		{
+			name: "explicit direct provider on a RapidAPI-looking domain",
+			newClient: func(c client.HttpClient) (*client.Client, error) {
+				return client.NewClient("test-api-key",
+					client.WithHTTPClient(c),
+					client.WithBaseURL("https://api-football-v1.p.rapidapi.com/v3/"),
+					client.WithProvider(client.Direct),
+				)
+			},
+			expectedProvider: client.Direct,
+			expectedHeaders: map[string]string{
+				"x-apisports-key": "test-api-key",
+				"X-RapidAPI-Key":  "",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockClient := newMockClient()
+			apiClient, err := tt.newClient(mockClient)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expectedProvider, apiClient.Provider())
+
+			_, err = apiClient.Leagues(map[string]any{"season": 2023})
+			assert.NoError(t, err)
 
-	_, err = apiClient.Leagues(map[string]any{"season": 2023})
-	assert.NoError(t, err)
+			headers := mockClient.LastRequest.Header
+			for key, value := range tt.expectedHeaders {
+				assert.Equal(t, value, headers.Get(key), key)
+			}
+		})
+	}
+}
 
-	headers := mockClient.LastRequest.Header
-	assert.Equal(t, "test-api-key", headers.Get("X-RapidAPI-Key"))
-	assert.Equal(t, "api-football-v1.p.rapidapi.com", headers.Get("X-RapidAPI-Host"))
-	assert.Equal(t, "test-api-key", headers.Get("x-apisports-key"))
+func TestWithProviderRejectsUnknownProvider(t *testing.T) {
+	_, err := client.NewClient("key", client.WithProvider("other"))
+	assert.EqualError(t, err, `unknown provider "other"`)
 }
 
 func TestNewWithDomain(t *testing.T) {
@@ -0,0 +1,79 @@
+package client_test
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	client "github.com/0ffsideCompass/api-football-go-client"
+	"github.com/0ffsideCompass/api-football-go-client/models"
+)
+
+func TestEnvelopeDecoding(t *testing.T) {
+	body := `{
+		"get": "teams/statistics",
+		"parameters": {"league": "39", "season": 2023, "team": "33"},
+		"errors": [],
+		"results": 11,
+		"paging": {"current": 1, "total": 1},
+		"response": {"league": {"id": 39}, "team": {"id": 33, "name": "Manchester United"}, "form": "WLW"}
+	}`
+
+	resp, err := newTestClient(t, body).TeamsStatistics(map[string]any{"league": 39, "season": 2023, "team": 33})
+	assert.NoError(t, err)
+	assert.Equal(t, "teams/statistics", resp.Get)
+	assert.Equal(t, models.Parameters{"league": "39", "season": "2023", "team": "33"}, resp.Parameters)
+	assert.Equal(t, "Manchester United", resp.Response.Team.Name)
+	assert.False(t, resp.IsEmpty())
+	assert.NoError(t, resp.Err())
+}
+
+func TestEnvelopeHelpers(t *testing.T) {
+	tests := []struct {
+		name       string
+		body       string
+		wantEmpty  bool
+		wantParams models.Parameters
+		wantErr    error
+	}{
+		{
+			name:       "no parameters and no results",
+			body:       `{"parameters": [], "errors": [], "results": 0, "response": []}`,
+			wantEmpty:  true,
+			wantParams: models.Parameters{},
+		},
+		{
+			name:       "errors reported in the body",
+			body:       `{"parameters": {"fixture": "1"}, "errors": {"token": "Error/Missing application key."}, "results": 0, "response": []}`,
+			wantEmpty:  true,
+			wantParams: models.Parameters{"fixture": "1"},
+			wantErr:    client.ErrAuth,
+		},
+		{
+			name:       "results",
+			body:       `{"parameters": {"id": 33}, "errors": [], "results": 1, "response": [{"team": {"id": 33}}]}`,
+			wantParams: models.Parameters{"id": "33"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var resp models.TeamsResponse
+			assert.NoError(t, json.Unmarshal([]byte(tt.body), &resp))
+			assert.Equal(t, tt.wantEmpty, resp.IsEmpty())
+			assert.Equal(t, tt.wantParams, resp.Parameters)
+
+			err := resp.Err()
+			if tt.wantErr == nil {
+				assert.NoError(t, err)
+				return
+			}
+			assert.ErrorIs(t, err, tt.wantErr)
+			assert.ErrorIs(t, err, models.ErrAuth)
+			var apiErr *client.APIError
+			assert.True(t, errors.As(err, &apiErr))
+		})
+	}
+}
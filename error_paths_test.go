@@ -23,30 +23,60 @@ var endpointCalls = []struct {
 	{name: "Countries", call: func(c *client.Client) error { _, err := c.Countries(nil); return err }},
 	{name: "Leagues", call: func(c *client.Client) error { _, err := c.Leagues(nil); return err }},
 	{name: "LeaguesSeasons", call: func(c *client.Client) error { _, err := c.LeaguesSeasons(); return err }},
-	{name: "Teams", call: func(c *client.Client) error { _, err := c.Teams(nil); return err }},
-	{name: "TeamsStatistics", call: func(c *client.Client) error { _, err := c.TeamsStatistics(nil); return err }},
+	{name: "Teams", call: func(c *client.Client) error {
+		_, err := c.Teams(map[string]any{"id": 33})
+		return err
+	}},
+	{name: "TeamsStatistics", call: func(c *client.Client) error {
+		_, err := c.TeamsStatistics(map[string]any{"league": 39, "season": 2023, "team": 33})
+		return err
+	}},
 	{name: "TeamsSeasons", call: func(c *client.Client) error {
 		_, err := c.TeamsSeasons(map[string]any{"team": 33})
 		return err
 	}},
 	{name: "TeamsCountries", call: func(c *client.Client) error { _, err := c.TeamsCountries(); return err }},
-	{name: "Venues", call: func(c *client.Client) error { _, err := c.Venues(nil); return err }},
-	{name: "Standings", call: func(c *client.Client) error { _, err := c.Standings(nil); return err }},
-	{name: "Fixture", call: func(c *client.Client) error { _, err := c.Fixture(nil); return err }},
+	{name: "Venues", call: func(c *client.Client) error {
+		_, err := c.Venues(map[string]any{"id": 556})
+		return err
+	}},
+	{name: "Standings", call: func(c *client.Client) error {
+		_, err := c.Standings(map[string]any{"league": 39, "season": 2023})
+		return err
+	}},
+	{name: "Fixture", call: func(c *client.Client) error {
+		_, err := c.Fixture(map[string]any{"id": 1035037})
+		return err
+	}},
 	{name: "FixturesRounds", call: func(c *client.Client) error {
 		_, err := c.FixturesRounds(map[string]any{"league": 39, "season": 2023})
 		return err
 	}},
-	{name: "FixturesLineups", call: func(c *client.Client) error { _, err := c.FixturesLineups(nil); return err }},
-	{name: "FixturesEvents", call: func(c *client.Client) error { _, err := c.FixturesEvents(nil); return err }},
-	{name: "FixtureHeadToHead", call: func(c *client.Client) error { _, err := c.FixtureHeadToHead(nil); return err }},
+	{name: "FixturesLineups", call: func(c *client.Client) error {
+		_, err := c.FixturesLineups(map[string]any{"fixture": 1035037})
+		return err
+	}},
+	{name: "FixturesEvents", call: func(c *client.Client) error {
+		_, err := c.FixturesEvents(map[string]any{"fixture": 1035037})
+		return err
+	}},
+	{name: "FixtureHeadToHead", call: func(c *client.Client) error {
+		_, err := c.FixtureHeadToHead(map[string]any{"h2h": "33-34"})
+		return err
+	}},
 	{name: "FixtureByDateAndLeague", call: func(c *client.Client) error {
 		day := time.Date(2023, 8, 1, 0, 0, 0, 0, time.UTC)
 		_, err := c.FixtureByDateAndLeague(39, 2023, day, day)
 		return err
 	}},
-	{name: "FixtureStatistics", call: func(c *client.Client) error { _, err := c.FixtureStatistics(nil); return err }},
-	{name: "FixturesPlayer", call: func(c *client.Client) error { _, err := c.FixturesPlayer(nil); return err }},
+	{name: "FixtureStatistics", call: func(c *client.Client) error {
+		_, err := c.FixtureStatistics(map[string]any{"fixture": 1035037})
+		return err
+	}},
+	{name: "FixturesPlayer", call: func(c *client.Client) error {
+		_, err := c.FixturesPlayer(map[string]any{"fixture": 1035037})
+		return err
+	}},
 	{name: "Injuries", call: func(c *client.Client) error {
 		_, err := c.Injuries(map[string]any{"fixture": 157304})
 		return err
@@ -63,7 +93,10 @@ var endpointCalls = []struct {
 		_, err := c.PlayersSeasons(map[string]any{"player": 276})
 		return err
 	}},
-	{name: "Players", call: func(c *client.Client) error { _, err := c.Players(nil); return err }},
+	{name: "Players", call: func(c *client.Client) error {
+		_, err := c.Players(map[string]any{"id": 276, "season": 2023})
+		return err
+	}},
 	{name: "PlayersProfiles", call: func(c *client.Client) error { _, err := c.PlayersProfiles(nil); return err }},
 	{name: "PlayersSquads", call: func(c *client.Client) error {
 		_, err := c.PlayersSquads(map[string]any{"team": 33})
@@ -73,10 +106,22 @@ var endpointCalls = []struct {
 		_, err := c.PlayersTeams(map[string]any{"player": 276})
 		return err
 	}},
-	{name: "PlayersTopScorers", call: func(c *client.Client) error { _, err := c.PlayersTopScorers(nil); return err }},
-	{name: "PlayersTopAssists", call: func(c *client.Client) error { _, err := c.PlayersTopAssists(nil); return err }},
-	{name: "PlayersTopYellowCards", call: func(c *client.Client) error { _, err := c.PlayersTopYellowCards(nil); return err }},
-	{name: "PlayersTopRedCards", call: func(c *client.Client) error { _, err := c.PlayersTopRedCards(nil); return err }},
+	{name: "PlayersTopScorers", call: func(c *client.Client) error {
+		_, err := c.PlayersTopScorers(map[string]any{"league": 39, "season": 2023})
+		return err
+	}},
+	{name: "PlayersTopAssists", call: func(c *client.Client) error {
+		_, err := c.PlayersTopAssists(map[string]any{"league": 39, "season": 2023})
+		return err
+	}},
+	{name: "PlayersTopYellowCards", call: func(c *client.Client) error {
+		_, err := c.PlayersTopYellowCards(map[string]any{"league": 39, "season": 2023})
+		return err
+	}},
+	{name: "PlayersTopRedCards", call: func(c *client.Client) error {
+		_, err := c.PlayersTopRedCards(map[string]any{"league": 39, "season": 2023})
+		return err
+	}},
 	{name: "Transfers", call: func(c *client.Client) error {
 		_, err := c.Transfers(map[string]any{"player": 276})
 		return err
@@ -89,7 +134,10 @@ var endpointCalls = []struct {
 		_, err := c.Sidelined(map[string]any{"player": 276})
 		return err
 	}},
-	{name: "Odds", call: func(c *client.Client) error { _, err := c.Odds(nil); return err }},
+	{name: "Odds", call: func(c *client.Client) error {
+		_, err := c.Odds(map[string]any{"fixture": 1035037})
+		return err
+	}},
 	{name: "OddsMapping", call: func(c *client.Client) error { _, err := c.OddsMapping(nil); return err }},
 	{name: "OddsBookmakers", call: func(c *client.Client) error { _, err := c.OddsBookmakers(nil); return err }},
 	{name: "OddsBets", call: func(c *client.Client) error { _, err := c.OddsBets(nil); return err }},
@@ -210,3 +258,274 @@ func TestInjuriesValidation(t *testing.T) {
 		})
 	}
 }
+
+func TestParamValidation(t *testing.T) {
+	ids := make([]int, 21)
+	for i := range ids {
+		ids[i] = i + 1
+	}
+
+	tests := []struct {
+		name    string
+		call    func(c *client.Client) error
+		wantErr string
+	}{
+		{
+			name: "float64 IDs are accepted by PlayersSeasons",
+			call: func(c *client.Client) error {
+				_, err := c.PlayersSeasons(map[string]any{"player": 276.0})
+				return err
+			},
+		},
+		{
+			name: "float64 IDs are accepted by PlayersSquads",
+			call: func(c *client.Client) error {
+				_, err := c.PlayersSquads(map[string]any{"team": 33.0, "player": 276.0})
+				return err
+			},
+		},
+		{
+			name: "every violation is listed",
+			call: func(c *client.Client) error {
+				_, err := c.Injuries(map[string]any{"team": "abc", "date": "2023/01/01"})
+				return err
+			},
+			wantErr: "3 invalid parameters for injuries: 'season' is required when 'team' is provided; " +
+				"'date' must be in the format 'YYYY-MM-DD'; 'team' must be an integer",
+		},
+		{
+			name: "too many fixture ids",
+			call: func(c *client.Client) error {
+				_, err := c.Fixture(map[string]any{"ids": ids})
+				return err
+			},
+			wantErr: "'ids' must hold at most 20 IDs",
+		},
+		{
+			name: "date range needs both ends",
+			call: func(c *client.Client) error {
+				_, err := c.Fixture(map[string]any{"league": 39, "season": 2023, "from": "2023-08-01"})
+				return err
+			},
+			wantErr: "'to' is required when 'from' is provided",
+		},
+		{
+			name: "fixture counts have at most 2 digits",
+			call: func(c *client.Client) error {
+				_, err := c.Fixture(map[string]any{"team": 33, "last": 100})
+				return err
+			},
+			wantErr: "'last' must be a positive integer of at most 2 digits",
+		},
+		{
+			name: "fixtures by date and league are validated",
+			call: func(c *client.Client) error {
+				from := time.Date(2023, 8, 1, 0, 0, 0, 0, time.UTC)
+				_, err := c.FixtureByDateAndLeague(39, 23, from, from.AddDate(0, 1, 0))
+				return err
+			},
+			wantErr: "'season' must be 4 digits",
+		},
+		{
+			name: "head to head needs two teams",
+			call: func(c *client.Client) error {
+				_, err := c.FixtureHeadToHead(map[string]any{"h2h": "33"})
+				return err
+			},
+			wantErr: "'h2h' must be two integers in the format 'id-id'",
+		},
+		{
+			name: "player search needs a league or team and 4 characters",
+			call: func(c *client.Client) error {
+				_, err := c.Players(map[string]any{"search": "Ron"})
+				return err
+			},
+			wantErr: "2 invalid parameters for players: one of 'league' or 'team' is required when 'search' is provided; " +
+				"'search' must be at least 4 characters long",
+		},
+		{
+			name: "standings need a league or team",
+			call: func(c *client.Client) error {
+				_, err := c.Standings(map[string]any{"season": 2023})
+				return err
+			},
+			wantErr: "at least one of 'league' or 'team' must be provided",
+		},
+		{
+			name: "flags must be booleans",
+			call: func(c *client.Client) error {
+				_, err := c.Leagues(map[string]any{"current": "yes"})
+				return err
+			},
+			wantErr: "'current' must be 'true' or 'false'",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			apiClient := newTestClient(t, `{"response": [], "errors": [], "results": 0}`)
+
+			err := tt.call(apiClient)
+			if tt.wantErr == "" {
+				assert.NoError(t, err)
+				return
+			}
+			assert.EqualError(t, err, tt.wantErr)
+			assert.ErrorIs(t, err, client.ErrInvalidParameter)
+
+			var validationErr *client.ValidationError
+			assert.ErrorAs(t, err, &validationErr)
+		})
+	}
+}
+
+func TestStrictParams(t *testing.T) {
+	tests := []struct {
+		name    string
+		strict  bool
+		params  map[string]any
+		wantErr string
+	}{
+		{
+			name:   "unknown parameters are forwarded by default",
+			params: map[string]any{"leauge": 39, "season": 2023},
+		},
+		{
+			name:   "known parameters pass in strict mode",
+			strict: true,
+			params: map[string]any{"league": 39, "season": 2023},
+		},
+		{
+			name:    "a transposition gets a suggestion",
+			strict:  true,
+			params:  map[string]any{"leauge": 39, "season": 2023},
+			wantErr: "unknown parameter 'leauge', did you mean 'league'?",
+		},
+		{
+			name:    "a distant name gets no suggestion",
+			strict:  true,
+			params:  map[string]any{"competition": 39},
+			wantErr: "unknown parameter 'competition'",
+		},
+		{
+			name:   "every unknown parameter is listed",
+			strict: true,
+			params: map[string]any{"sesaon": 2023, "tem": 33},
+			wantErr: "2 invalid parameters for fixtures: unknown parameter 'sesaon', did you mean 'season'?; " +
+				"unknown parameter 'tem', did you mean 'team'?",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			opts := []client.Option{client.WithHTTPClient(&MockHTTPClient{
+				Response: &http.Response{
+					StatusCode: http.StatusOK,
+					Body:       io.NopCloser(bytes.NewBufferString(`{"response": [], "errors": [], "results": 0}`)),
+				},
+			})}
+			if tt.strict {
+				opts = append(opts, client.WithStrictParams())
+			}
+			apiClient, err := client.NewClient("test-api-key", opts...)
+			assert.NoError(t, err)
+
+			_, err = apiClient.Fixture(tt.params)
+			if tt.wantErr == "" {
+				assert.NoError(t, err)
+				return
+			}
+			assert.EqualError(t, err, tt.wantErr)
+			assert.ErrorIs(t, err, client.ErrInvalidParameter)
+		})
+	}
+}
+
+func TestEndpointAPIErrors(t *testing.T) {
+	body := `{"get": "x", "parameters": [],
+		"errors": {"requests": "You have reached the request limit for the day"},
+		"results": 0, "paging": {"current": 1, "total": 1}, "response": []}`
+	for _, tt := range endpointCalls {
+		t.Run(tt.name, func(t *testing.T) {
+			apiClient := newTestClient(t, body)
+
+			err := tt.call(apiClient)
+			assert.ErrorIs(t, err, client.ErrQuotaExceeded)
+
+			var apiErr *client.APIError
+			assert.ErrorAs(t, err, &apiErr)
+			assert.Equal(t, "You have reached the request limit for the day", apiErr.Errors["requests"])
+		})
+	}
+}
+
+func TestAPIErrorShapes(t *testing.T) {
+	tests := []struct {
+		name     string
+		errors   string
+		wantErr  string
+		matches  []error
+		excludes []error
+	}{
+		{
+			name:   "empty array is not an error",
+			errors: `[]`,
+		},
+		{
+			name:   "empty object is not an error",
+			errors: `{}`,
+		},
+		{
+			name:     "missing token",
+			errors:   `{"token": "Error/Missing application key."}`,
+			wantErr:  "api error: token: Error/Missing application key.",
+			matches:  []error{client.ErrAuth},
+			excludes: []error{client.ErrQuotaExceeded, client.ErrInvalidParameter},
+		},
+		{
+			name:     "per-minute rate limit",
+			errors:   `{"rateLimit": "Too many requests. Your rate limit is 10 requests per minute."}`,
+			wantErr:  "api error: rateLimit: Too many requests. Your rate limit is 10 requests per minute.",
+			matches:  []error{client.ErrQuotaExceeded},
+			excludes: []error{client.ErrAuth, client.ErrInvalidParameter},
+		},
+		{
+			name:     "several invalid parameters are listed in key order",
+			errors:   `{"season": "The Season field must contain 4 characters.", "league": "The League field must contain an integer."}`,
+			wantErr:  "api error: league: The League field must contain an integer.; season: The Season field must contain 4 characters.",
+			matches:  []error{client.ErrInvalidParameter},
+			excludes: []error{client.ErrAuth, client.ErrQuotaExceeded},
+		},
+		{
+			name:    "array of objects",
+			errors:  `[{"required": "At least one parameter is required."}]`,
+			wantErr: "api error: required: At least one parameter is required.",
+			matches: []error{client.ErrInvalidParameter},
+		},
+		{
+			name:    "array of strings",
+			errors:  `["Something went wrong"]`,
+			wantErr: "api error: 0: Something went wrong",
+			matches: []error{client.ErrInvalidParameter},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			apiClient := newTestClient(t, `{"errors": `+tt.errors+`, "results": 0, "response": []}`)
+
+			_, err := apiClient.Leagues(nil)
+			if tt.wantErr == "" {
+				assert.NoError(t, err)
+				return
+			}
+			assert.EqualError(t, err, "error getting leagues response: "+tt.wantErr)
+			for _, target := range tt.matches {
+				assert.ErrorIs(t, err, target)
+			}
+			for _, target := range tt.excludes {
+				assert.NotErrorIs(t, err, target)
+			}
+		})
+	}
+}
@@ -0,0 +1,66 @@
+package client
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/0ffsideCompass/api-football-go-client/models"
+)
+
+// Sentinel errors for the categories of failure API-Football reports inside
+// a 200 OK body. They are the same values as the models package's, so
+// either can be used with errors.Is:
+//
+//	if errors.Is(err, client.ErrQuotaExceeded) {
+//		// back off until the quota resets
+//	}
+var (
+	// ErrQuotaExceeded reports that the daily or per-minute request quota
+	// is exhausted.
+	ErrQuotaExceeded = models.ErrQuotaExceeded
+	// ErrAuth reports a missing, invalid, or suspended API key.
+	ErrAuth = models.ErrAuth
+	// ErrInvalidParameter reports a rejected query parameter or parameter
+	// combination.
+	ErrInvalidParameter = models.ErrInvalidParameter
+)
+
+// APIError is returned when API-Football answers with a 200 OK whose
+// "errors" field is not empty. See models.APIError.
+type APIError = models.APIError
+
+// checkAPIErrors returns an *APIError if body is a response envelope with a
+// non-empty "errors" field. Bodies that cannot be decoded are left for the
+// caller to report.
+func checkAPIErrors(body []byte) error {
+	var envelope models.Envelope[json.RawMessage]
+	if err := json.Unmarshal(body, &envelope); err != nil {
+		return nil
+	}
+	return envelope.Err()
+}
+
+// ValidationError is returned, before any request is sent, when the
+// parameters passed to an endpoint method break the endpoint's rules. It
+// lists every violation found and matches ErrInvalidParameter.
+type ValidationError struct {
+	// Endpoint is the endpoint path, e.g. "fixtures/lineups".
+	Endpoint string
+	// Violations describes each problem, e.g. "'season' must be 4 digits".
+	Violations []string
+}
+
+// Error returns the violation, or the list of violations when there are
+// several.
+func (e *ValidationError) Error() string {
+	if len(e.Violations) == 1 {
+		return e.Violations[0]
+	}
+	return fmt.Sprintf("%d invalid parameters for %s: %s", len(e.Violations), e.Endpoint, strings.Join(e.Violations, "; "))
+}
+
+// Is reports whether target is ErrInvalidParameter.
+func (e *ValidationError) Is(target error) bool {
+	return target == ErrInvalidParameter
+}
@@ -104,3 +104,15 @@ func ExampleClient_Search() {
 
 	fmt.Println(string(body))
 }
+
+func ExampleNewClient() {
+	cli, err := client.NewClient("YOUR_API_KEY",
+		client.WithTimeout(10*time.Second),
+		client.WithUserAgent("my-app/1.0"),
+		client.WithRateLimiter(client.NewRateLimiter(300, 7500)),
+	)
+	if err != nil {
+		log.Fatalf("creating client: %v", err)
+	}
+	fmt.Println(cli.Domain)
+}
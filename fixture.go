@@ -1,15 +1,16 @@
 package client
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
+	"sync"
 	"time"
 
 	"github.com/0ffsideCompass/api-football-go-client/models"
 )
 
 const (
-	fixturesByDateEndpoint    = "fixtures?league=%d&season=%d&from=%s&to=%s"
 	fixtureHeadToHeadEndpoint = "fixtures/headtohead"
 	fixtureRoundsEndpoint     = "fixtures/rounds"
 	fixtureEndpoint           = "fixtures"
@@ -31,8 +32,22 @@ const (
 func (c *Client) FixturesLineups(
 	params map[string]any,
 ) (*models.FixturesLineupsResponse, error) {
+	return c.FixturesLineupsContext(context.Background(), params)
+}
+
+// FixturesLineupsContext is like FixturesLineups but with a context.
+func (c *Client) FixturesLineupsContext(
+	ctx context.Context,
+	params map[string]any,
+) (*models.FixturesLineupsResponse, error) {
+	// Validate the parameters
+	if err := c.validateParams(fixtureLineupsEndpoint, params); err != nil {
+		return nil, err
+	}
+
 	endpointURL := fmt.Sprintf("%s%s", c.Domain, fixtureLineupsEndpoint)
 	body, err := c.get(
+		ctx,
 		c.buildURL(
 			endpointURL,
 			params,
@@ -53,6 +68,11 @@ func (c *Client) FixturesLineups(
 	return &resp, nil
 }
 
+// FixturesLineupsWith is like FixturesLineupsContext but takes typed parameters.
+func (c *Client) FixturesLineupsWith(ctx context.Context, params FixturesLineupsParams) (*models.FixturesLineupsResponse, error) {
+	return c.FixturesLineupsContext(ctx, params.Encode())
+}
+
 // FixturesRounds returns the rounds of a league for a given season.
 /*
 	- league: (Type: integer) (Required)
@@ -68,14 +88,23 @@ func (c *Client) FixturesLineups(
 */
 func (c *Client) FixturesRounds(
 	params map[string]any,
+) (*models.FixturesRoundsResponse, error) {
+	return c.FixturesRoundsContext(context.Background(), params)
+}
+
+// FixturesRoundsContext is like FixturesRounds but with a context.
+func (c *Client) FixturesRoundsContext(
+	ctx context.Context,
+	params map[string]any,
 ) (*models.FixturesRoundsResponse, error) {
 	// Validate the parameters
-	if err := requireIntParams(params, "league", "season"); err != nil {
+	if err := c.validateParams(fixtureRoundsEndpoint, params); err != nil {
 		return nil, err
 	}
 
 	endpointURL := fmt.Sprintf("%s%s", c.Domain, fixtureRoundsEndpoint)
 	body, err := c.get(
+		ctx,
 		c.buildURL(
 			endpointURL,
 			params,
@@ -96,6 +125,11 @@ func (c *Client) FixturesRounds(
 	return &resp, nil
 }
 
+// FixturesRoundsWith is like FixturesRoundsContext but takes typed parameters.
+func (c *Client) FixturesRoundsWith(ctx context.Context, params FixturesRoundsParams) (*models.FixturesRoundsResponse, error) {
+	return c.FixturesRoundsContext(ctx, params.Encode())
+}
+
 // FixturesEvents returns all events for a given fixture
 /*
 	- fixture: (Type: integer)(Required)
@@ -108,8 +142,22 @@ func (c *Client) FixturesRounds(
 func (c *Client) FixturesEvents(
 	params map[string]any,
 ) (*models.FixturesEventsResponse, error) {
+	return c.FixturesEventsContext(context.Background(), params)
+}
+
+// FixturesEventsContext is like FixturesEvents but with a context.
+func (c *Client) FixturesEventsContext(
+	ctx context.Context,
+	params map[string]any,
+) (*models.FixturesEventsResponse, error) {
+	// Validate the parameters
+	if err := c.validateParams(fixturesEventsEndpoint, params); err != nil {
+		return nil, err
+	}
+
 	endpointURL := fmt.Sprintf("%s%s", c.Domain, fixturesEventsEndpoint)
 	body, err := c.get(
+		ctx,
 		c.buildURL(
 			endpointURL,
 			params,
@@ -130,6 +178,11 @@ func (c *Client) FixturesEvents(
 	return &resp, nil
 }
 
+// FixturesEventsWith is like FixturesEventsContext but takes typed parameters.
+func (c *Client) FixturesEventsWith(ctx context.Context, params FixturesEventsParams) (*models.FixturesEventsResponse, error) {
+	return c.FixturesEventsContext(ctx, params.Encode())
+}
+
 // Fixture returns all fixtures for parameters passed
 /*
 - id: (Type: integer)
@@ -178,13 +231,31 @@ func (c *Client) FixturesEvents(
 
 - venue: (Type: integer)
   The venue ID of the fixture.
+- timezone: (Type: string)
+  A timezone from the Timezone endpoint.
+
+At least one parameter must be passed; from and to must be passed together.
 */
 func (c *Client) Fixture(
 	params map[string]any,
 ) (*models.FixturesResponse, error) {
+	return c.FixtureContext(context.Background(), params)
+}
+
+// FixtureContext is like Fixture but with a context.
+func (c *Client) FixtureContext(
+	ctx context.Context,
+	params map[string]any,
+) (*models.FixturesResponse, error) {
+	// Validate the parameters
+	if err := c.validateParams(fixtureEndpoint, params); err != nil {
+		return nil, err
+	}
+
 	endpointURL := fmt.Sprintf("%s%s", c.Domain, fixtureEndpoint)
 
 	body, err := c.get(
+		ctx,
 		c.buildURL(
 			endpointURL,
 			params,
@@ -204,6 +275,128 @@ func (c *Client) Fixture(
 	return &resp, nil
 }
 
+// FixtureWith is like FixtureContext but takes typed parameters.
+func (c *Client) FixtureWith(ctx context.Context, params FixturesParams) (*models.FixturesResponse, error) {
+	return c.FixtureContext(ctx, params.Encode())
+}
+
+// fixturesByIDsConcurrency is the number of requests FixturesByIDs sends
+// at once.
+const fixturesByIDsConcurrency = 4
+
+// FixturesByIDs returns the fixtures with the given IDs, each with its
+// events, lineups, statistics and player statistics, which the /fixtures
+// endpoint embeds when fixtures are fetched by ID. The IDs are fetched 20 at
+// a time with the 'ids' parameter, a few requests at once.
+//
+// Fixtures are returned in the order of ids, once each even if an ID is
+// repeated. IDs the API did not return are reported in missing, in the
+// order of ids. If a request fails, FixturesByIDs stops and returns its
+// error.
+func (c *Client) FixturesByIDs(ctx context.Context, ids []int) (fixtures []models.FixtureDetails, missing []int, err error) {
+	var unique []int
+	seen := make(map[int]bool, len(ids))
+	for _, id := range ids {
+		if id <= 0 {
+			return nil, nil, fmt.Errorf("invalid fixture ID %d", id)
+		}
+		if !seen[id] {
+			seen[id] = true
+			unique = append(unique, id)
+		}
+	}
+
+	found := make(map[int]models.FixtureDetails, len(unique))
+	collect := func(_ []int, batch []models.FixtureDetails, err error) error {
+		if err != nil {
+			return err
+		}
+		for _, f := range batch {
+			found[f.Fixture.ID] = f
+		}
+		return nil
+	}
+	err = c.fetchFixtureBatches(ctx, chunkIDs(unique), fixturesByIDsConcurrency, collect)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	fixtures = make([]models.FixtureDetails, 0, len(found))
+	for _, id := range unique {
+		if f, ok := found[id]; ok {
+			fixtures = append(fixtures, f)
+		} else {
+			missing = append(missing, id)
+		}
+	}
+	return fixtures, missing, nil
+}
+
+// chunkIDs splits ids into chunks of at most maxIDs, the most the 'ids'
+// parameter accepts.
+func chunkIDs(ids []int) [][]int {
+	var chunks [][]int
+	for len(ids) > 0 {
+		chunk := ids[:min(len(ids), maxIDs)]
+		ids = ids[len(chunk):]
+		chunks = append(chunks, chunk)
+	}
+	return chunks
+}
+
+// fixtureBatchHandler handles the response to the request of a batch of
+// fixture IDs: the fixtures returned, or the error of the request.
+type fixtureBatchHandler func(batch []int, fixtures []models.FixtureDetails, err error) error
+
+// fetchFixtureBatches fetches each batch of fixture IDs with the 'ids'
+// parameter, concurrency batches at once, and passes the outcome of every
+// request made to handle, which is never called concurrently. The first
+// error handle returns stops the batches not yet sent and is returned.
+func (c *Client) fetchFixtureBatches(ctx context.Context, batches [][]int, concurrency int, handle fixtureBatchHandler) error {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	var (
+		mu       sync.Mutex
+		firstErr error
+		next     = make(chan []int)
+		wg       sync.WaitGroup
+	)
+	for range min(concurrency, len(batches)) {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for batch := range next {
+				resp, err := c.FixtureContext(ctx, map[string]any{"ids": batch})
+				mu.Lock()
+				if err != nil {
+					err = handle(batch, nil, fmt.Errorf("error fetching fixtures %v: %w", batch, err))
+				} else {
+					err = handle(batch, resp.Response, nil)
+				}
+				if err != nil && firstErr == nil {
+					firstErr = err
+					cancel()
+				}
+				mu.Unlock()
+			}
+		}()
+	}
+feed:
+	for _, batch := range batches {
+		select {
+		case next <- batch:
+		case <-ctx.Done():
+			break feed
+		}
+	}
+	close(next)
+	wg.Wait()
+	if firstErr != nil {
+		return firstErr
+	}
+	return ctx.Err()
+}
+
 // FixtureHeadToHead returns head to head for two teams
 /*
 	- h2h: (Type: string)(Required)(format id-id)
@@ -232,9 +425,23 @@ func (c *Client) Fixture(
 func (c *Client) FixtureHeadToHead(
 	params map[string]any,
 ) (*models.FixtureHeadToHeadResp, error) {
+	return c.FixtureHeadToHeadContext(context.Background(), params)
+}
+
+// FixtureHeadToHeadContext is like FixtureHeadToHead but with a context.
+func (c *Client) FixtureHeadToHeadContext(
+	ctx context.Context,
+	params map[string]any,
+) (*models.FixtureHeadToHeadResp, error) {
+	// Validate the parameters
+	if err := c.validateParams(fixtureHeadToHeadEndpoint, params); err != nil {
+		return nil, err
+	}
+
 	endpointURL := fmt.Sprintf("%s%s", c.Domain, fixtureHeadToHeadEndpoint)
 
 	body, err := c.get(
+		ctx,
 		c.buildURL(
 			endpointURL,
 			params,
@@ -252,6 +459,11 @@ func (c *Client) FixtureHeadToHead(
 	return &resp, nil
 }
 
+// FixtureHeadToHeadWith is like FixtureHeadToHeadContext but takes typed parameters.
+func (c *Client) FixtureHeadToHeadWith(ctx context.Context, params FixtureHeadToHeadParams) (*models.FixtureHeadToHeadResp, error) {
+	return c.FixtureHeadToHeadContext(ctx, params.Encode())
+}
+
 // FixtureByDateAndLeague returns all fixtures for the given league and season
 // that take place between fromDate and toDate (inclusive). Only the date part
 // of fromDate and toDate is used.
@@ -261,13 +473,34 @@ func (c *Client) FixtureByDateAndLeague(
 	fromDate time.Time,
 	toDate time.Time,
 ) (*models.FixturesByDateResp, error) {
+	return c.FixtureByDateAndLeagueContext(context.Background(), leagueID, season, fromDate, toDate)
+}
+
+// FixtureByDateAndLeagueContext is like FixtureByDateAndLeague but with a context.
+func (c *Client) FixtureByDateAndLeagueContext(
+	ctx context.Context,
+	leagueID,
+	season int,
+	fromDate time.Time,
+	toDate time.Time,
+) (*models.FixturesByDateResp, error) {
+	params := map[string]any{
+		"league": leagueID,
+		"season": season,
+		"from":   c.formatDate(fromDate),
+		"to":     c.formatDate(toDate),
+	}
+	// Validate the parameters
+	if err := c.validateParams(fixtureEndpoint, params); err != nil {
+		return nil, err
+	}
+
+	endpointURL := fmt.Sprintf("%s%s", c.Domain, fixtureEndpoint)
 	body, err := c.get(
-		c.Domain + fmt.Sprintf(
-			fixturesByDateEndpoint,
-			leagueID,
-			season,
-			c.formatDate(fromDate),
-			c.formatDate(toDate),
+		ctx,
+		c.buildURL(
+			endpointURL,
+			params,
 		),
 	)
 	if err != nil {
@@ -295,8 +528,22 @@ func (c *Client) FixtureByDateAndLeague(
 func (c *Client) FixtureStatistics(
 	params map[string]any,
 ) (*models.FixturesStatisticsResponse, error) {
+	return c.FixtureStatisticsContext(context.Background(), params)
+}
+
+// FixtureStatisticsContext is like FixtureStatistics but with a context.
+func (c *Client) FixtureStatisticsContext(
+	ctx context.Context,
+	params map[string]any,
+) (*models.FixturesStatisticsResponse, error) {
+	// Validate the parameters
+	if err := c.validateParams(fixtureStatisticsEndpoint, params); err != nil {
+		return nil, err
+	}
+
 	endpointURL := fmt.Sprintf("%s%s", c.Domain, fixtureStatisticsEndpoint)
 	body, err := c.get(
+		ctx,
 		c.buildURL(
 			endpointURL,
 			params,
@@ -317,6 +564,11 @@ func (c *Client) FixtureStatistics(
 	return &resp, nil
 }
 
+// FixtureStatisticsWith is like FixtureStatisticsContext but takes typed parameters.
+func (c *Client) FixtureStatisticsWith(ctx context.Context, params FixtureStatisticsParams) (*models.FixturesStatisticsResponse, error) {
+	return c.FixtureStatisticsContext(ctx, params.Encode())
+}
+
 // FixturesPlayer returns fixture's player data
 /*
 	- fixture (Type: integer)(Required)
@@ -327,8 +579,22 @@ func (c *Client) FixtureStatistics(
 func (c *Client) FixturesPlayer(
 	params map[string]any,
 ) (*models.FixturesPlayersResponse, error) {
+	return c.FixturesPlayerContext(context.Background(), params)
+}
+
+// FixturesPlayerContext is like FixturesPlayer but with a context.
+func (c *Client) FixturesPlayerContext(
+	ctx context.Context,
+	params map[string]any,
+) (*models.FixturesPlayersResponse, error) {
+	// Validate the parameters
+	if err := c.validateParams(fixturePlayerEndpoint, params); err != nil {
+		return nil, err
+	}
+
 	endpointURL := fmt.Sprintf("%s%s", c.Domain, fixturePlayerEndpoint)
 	body, err := c.get(
+		ctx,
 		c.buildURL(
 			endpointURL,
 			params,
@@ -348,3 +614,8 @@ func (c *Client) FixturesPlayer(
 	}
 	return &resp, nil
 }
+
+// FixturesPlayerWith is like FixturesPlayerContext but takes typed parameters.
+func (c *Client) FixturesPlayerWith(ctx context.Context, params FixturesPlayersParams) (*models.FixturesPlayersResponse, error) {
+	return c.FixturesPlayerContext(ctx, params.Encode())
+}
@@ -0,0 +1,89 @@
+package client_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	client "github.com/0ffsideCompass/api-football-go-client"
+	"github.com/0ffsideCompass/api-football-go-client/models"
+)
+
+func TestFixtureStatusPredicates(t *testing.T) {
+	tests := []struct {
+		status                                                models.FixtureStatus
+		scheduled, live, finished, cancelled, result, isFinal bool
+	}{
+		{status: models.StatusTimeToBeDefined, scheduled: true},
+		{status: models.StatusNotStarted, scheduled: true},
+		{status: models.StatusFirstHalf, live: true},
+		{status: models.StatusHalftime, live: true},
+		{status: models.StatusSecondHalf, live: true},
+		{status: models.StatusExtraTime, live: true},
+		{status: models.StatusBreakTime, live: true},
+		{status: models.StatusPenaltyInProgress, live: true},
+		{status: models.StatusSuspended, live: true},
+		{status: models.StatusInterrupted, live: true},
+		{status: models.StatusLive, live: true},
+		{status: models.StatusFinished, finished: true, result: true, isFinal: true},
+		{status: models.StatusFinishedAfterExtra, finished: true, result: true, isFinal: true},
+		{status: models.StatusFinishedAfterPens, finished: true, result: true, isFinal: true},
+		{status: models.StatusPostponed},
+		{status: models.StatusCancelled, cancelled: true, isFinal: true},
+		{status: models.StatusAbandoned, cancelled: true, isFinal: true},
+		{status: models.StatusTechnicalLoss, result: true, isFinal: true},
+		{status: models.StatusWalkOver, result: true, isFinal: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(string(tt.status), func(t *testing.T) {
+			assert.True(t, tt.status.IsKnown())
+			assert.NotEmpty(t, tt.status.LongName())
+			assert.Equal(t, tt.scheduled, tt.status.IsScheduled(), "IsScheduled")
+			assert.Equal(t, tt.live, tt.status.IsLive(), "IsLive")
+			assert.Equal(t, tt.finished, tt.status.IsFinished(), "IsFinished")
+			assert.Equal(t, tt.cancelled, tt.status.IsCancelled(), "IsCancelled")
+			assert.Equal(t, tt.result, tt.status.HasResult(), "HasResult")
+			assert.Equal(t, tt.isFinal, tt.status.IsFinal(), "IsFinal")
+		})
+	}
+
+	unknown := models.FixtureStatus("XX")
+	assert.False(t, unknown.IsKnown())
+	assert.Empty(t, unknown.LongName())
+	assert.Equal(t, "Match Finished After Penalty", models.StatusFinishedAfterPens.LongName())
+}
+
+func TestStatusFilter(t *testing.T) {
+	filter := models.StatusFilter{models.StatusNotStarted, models.StatusPostponed, models.StatusFinished}
+	assert.Equal(t, "NS-PST-FT", filter.String())
+
+	parsed, err := models.ParseStatusFilter("NS-PST-FT")
+	assert.NoError(t, err)
+	assert.Equal(t, filter, parsed)
+
+	_, err = models.ParseStatusFilter("NS-XX")
+	assert.EqualError(t, err, `unknown fixture status "XX"`)
+
+	mockClient := newMockClient()
+	apiClient, err := client.New("test-api-key", mockClient)
+	assert.NoError(t, err)
+	_, err = apiClient.Fixture(map[string]any{"league": 39, "season": 2023, "status": filter})
+	assert.NoError(t, err)
+	assert.Equal(t, "https://v3.football.api-sports.io/fixtures?league=39&season=2023&status=NS-PST-FT",
+		mockClient.LastRequest.URL.String())
+
+	_, err = apiClient.FixtureHeadToHead(map[string]any{"h2h": "33-34", "status": "FT-XX"})
+	assert.EqualError(t, err, `'status' must be fixture statuses separated by '-': unknown fixture status "XX"`)
+}
+
+func TestFixtureStatusDecoding(t *testing.T) {
+	body := `{"response": [` + fixtureJSON + `], "errors": [], "results": 1}`
+	resp, err := newTestClient(t, body).FixtureHeadToHead(map[string]any{"h2h": "44-50"})
+	assert.NoError(t, err)
+
+	status := resp.Response[0].Fixture.Status.Short
+	assert.Equal(t, models.StatusFinished, status)
+	assert.True(t, status.HasResult())
+	assert.Equal(t, resp.Response[0].Fixture.Status.Long, status.LongName())
+}
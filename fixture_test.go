@@ -2,14 +2,19 @@ package client_test
 
 import (
 	"bytes"
+	"context"
 	"io"
 	"net/http"
+	"slices"
+	"strconv"
+	"strings"
 	"testing"
 	"time"
 
 	"github.com/stretchr/testify/assert"
 
 	client "github.com/0ffsideCompass/api-football-go-client"
+	"github.com/0ffsideCompass/api-football-go-client/models"
 )
 
 func TestFixturesLineups(t *testing.T) {
@@ -458,3 +463,104 @@ func TestFixturesPlayer(t *testing.T) {
 		})
 	}
 }
+
+// fixtureJSON is a fixture as the fixtures endpoints return it.
+const fixtureJSON = `{
+	"fixture": {
+		"id": 1035037, "referee": "S. Hooper", "timezone": "UTC",
+		"date": "2023-08-11T19:00:00+00:00", "timestamp": 1691780400,
+		"periods": {"first": 1691780400, "second": 1691784000},
+		"venue": {"id": 512, "name": "Turf Moor", "city": "Burnley"},
+		"status": {"long": "Match Finished", "short": "FT", "elapsed": 90}
+	},
+	"league": {
+		"id": 39, "name": "Premier League", "country": "England",
+		"logo": "", "flag": "", "season": 2023, "round": "Regular Season - 1"
+	},
+	"teams": {
+		"home": {"id": 44, "name": "Burnley", "logo": "", "winner": false},
+		"away": {"id": 50, "name": "Manchester City", "logo": "", "winner": true}
+	},
+	"goals": {"home": 0, "away": 3},
+	"score": {
+		"halftime": {"home": 0, "away": 2},
+		"fulltime": {"home": 0, "away": 3},
+		"extratime": {"home": null, "away": null},
+		"penalty": {"home": null, "away": null}
+	}
+}`
+
+// awayWin is written against the shared models.Fixture type.
+func awayWin(f models.Fixture) bool {
+	return f.Teams.Away.Winner && f.Goals.Away > f.Goals.Home
+}
+
+func TestFixtureModelsAreShared(t *testing.T) {
+	body := `{"response": [` + fixtureJSON + `], "errors": [], "results": 1}`
+
+	fixtures, err := newTestClient(t, body).Fixture(map[string]any{"id": 1035037})
+	assert.NoError(t, err)
+	h2h, err := newTestClient(t, body).FixtureHeadToHead(map[string]any{"h2h": "44-50"})
+	assert.NoError(t, err)
+	day := time.Date(2023, 8, 11, 0, 0, 0, 0, time.UTC)
+	byDate, err := newTestClient(t, body).FixtureByDateAndLeague(39, 2023, day, day)
+	assert.NoError(t, err)
+
+	summary := fixtures.Response[0].Summary()
+	assert.Equal(t, h2h.Response[0], summary)
+	assert.Equal(t, byDate.Response[0], summary)
+	assert.True(t, awayWin(summary))
+
+	assert.Equal(t, "Regular Season - 1", summary.League.Round)
+	assert.Equal(t, "Premier League", summary.League.Name)
+	assert.Equal(t, "Manchester City", summary.Teams.Away.Name)
+	assert.Equal(t, "Turf Moor", summary.Fixture.Venue.Name)
+	assert.Equal(t, 2, summary.Score.Halftime.Away)
+	assert.Nil(t, summary.Score.Penalty.Home)
+}
+
+func TestFixturesByIDs(t *testing.T) {
+	httpClient := &MockHTTPClient{Respond: respondOK(func(req *http.Request) string {
+		ids := strings.Split(req.URL.Query().Get("ids"), "-")
+		if slices.Contains(ids, "999") {
+			return `{"errors": {"ids": "The Ids field must contain at most 20 ids."}, "results": 0, "response": []}`
+		}
+		// The API answers in its own order, and skips unknown fixtures.
+		var fixtures []string
+		for i := len(ids) - 1; i >= 0; i-- {
+			id, _ := strconv.Atoi(ids[i])
+			if id%10 != 0 {
+				fixtures = append(fixtures, liveFixture(id, "FT", 90, 1, 0, liveEvent(10, 33, "Goal", "Normal Goal", "Rashford")))
+			}
+		}
+		return liveBody(fixtures...)
+	})}
+	apiClient, err := client.New("test-key", httpClient)
+	assert.NoError(t, err)
+
+	var ids []int
+	for id := 45; id >= 1; id-- {
+		ids = append(ids, id)
+	}
+	ids = append(ids, 45, 3) // repeated IDs are fetched and returned once
+	fixtures, missing, err := apiClient.FixturesByIDs(context.Background(), ids)
+	assert.NoError(t, err)
+	assert.Len(t, httpClient.URLs, 3)
+	assert.Equal(t, []int{40, 30, 20, 10}, missing)
+	assert.Len(t, fixtures, 41)
+	assert.Equal(t, 45, fixtures[0].Fixture.ID)
+	assert.Equal(t, 39, fixtures[5].Fixture.ID)
+	assert.Equal(t, 1, fixtures[40].Fixture.ID)
+	assert.Len(t, fixtures[0].Events, 1)
+
+	fixtures, missing, err = apiClient.FixturesByIDs(context.Background(), nil)
+	assert.NoError(t, err)
+	assert.Empty(t, fixtures)
+	assert.Empty(t, missing)
+
+	_, _, err = apiClient.FixturesByIDs(context.Background(), []int{1, 0})
+	assert.EqualError(t, err, "invalid fixture ID 0")
+
+	_, _, err = apiClient.FixturesByIDs(context.Background(), append(ids, 999))
+	assert.ErrorIs(t, err, client.ErrInvalidParameter)
+}
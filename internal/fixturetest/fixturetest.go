@@ -0,0 +1,43 @@
+// Package fixturetest builds the fixtures used by the tests of the table,
+// simulate and ratings packages.
+package fixturetest
+
+import (
+	"time"
+
+	"github.com/0ffsideCompass/api-football-go-client/models"
+)
+
+// Teams shared by the tests.
+var (
+	TeamA = models.TeamRef{ID: 1, Name: "A"}
+	TeamB = models.TeamRef{ID: 2, Name: "B"}
+	TeamC = models.TeamRef{ID: 3, Name: "C"}
+	TeamD = models.TeamRef{ID: 4, Name: "D"}
+)
+
+// Fixture returns fixture id between home and away, kicking off on the
+// given day of August 2023, with a status and, once it has a result, its
+// goals.
+func Fixture(
+	id, day int,
+	round string,
+	home, away models.TeamRef,
+	homeGoals, awayGoals int,
+	status models.FixtureStatus,
+) models.Fixture {
+	var f models.Fixture
+	f.Fixture.ID = id
+	f.Fixture.Date = time.Date(2023, 8, day, 15, 0, 0, 0, time.UTC)
+	f.Fixture.Status.Short = status
+	f.League.Round = round
+	f.Teams.Home.TeamRef = home
+	f.Teams.Away.TeamRef = away
+	f.Goals = models.Goals{Home: homeGoals, Away: awayGoals}
+	return f
+}
+
+// Upcoming returns a fixture not started yet.
+func Upcoming(id, day int, round string, home, away models.TeamRef) models.Fixture {
+	return Fixture(id, day, round, home, away, 0, 0, models.StatusNotStarted)
+}
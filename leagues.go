@@ -1,6 +1,7 @@
 package client
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 
@@ -38,8 +39,22 @@ const (
 func (c *Client) Leagues(
 	params map[string]any,
 ) (*models.LeaguesResponse, error) {
+	return c.LeaguesContext(context.Background(), params)
+}
+
+// LeaguesContext is like Leagues but with a context.
+func (c *Client) LeaguesContext(
+	ctx context.Context,
+	params map[string]any,
+) (*models.LeaguesResponse, error) {
+	// Validate the parameters
+	if err := c.validateParams(leaguesEndpoint, params); err != nil {
+		return nil, err
+	}
+
 	endpointURL := fmt.Sprintf("%s%s", c.Domain, leaguesEndpoint)
 	body, err := c.get(
+		ctx,
 		c.buildURL(
 			endpointURL,
 			params,
@@ -60,11 +75,21 @@ func (c *Client) Leagues(
 	return &resp, nil
 }
 
+// LeaguesWith is like LeaguesContext but takes typed parameters.
+func (c *Client) LeaguesWith(ctx context.Context, params LeaguesParams) (*models.LeaguesResponse, error) {
+	return c.LeaguesContext(ctx, params.Encode())
+}
+
 // LeaguesSeasons hits the /leagues/seasons endpoint. It returns the list of
 // available seasons as 4-digit years. This endpoint takes no parameters.
 func (c *Client) LeaguesSeasons() (*models.SeasonsResponse, error) {
+	return c.LeaguesSeasonsContext(context.Background())
+}
+
+// LeaguesSeasonsContext is like LeaguesSeasons but with a context.
+func (c *Client) LeaguesSeasonsContext(ctx context.Context) (*models.SeasonsResponse, error) {
 	endpointURL := fmt.Sprintf("%s%s", c.Domain, leaguesSeasonsEndpoint)
-	body, err := c.get(endpointURL)
+	body, err := c.get(ctx, endpointURL)
 	if err != nil {
 		return nil, fmt.Errorf("error getting leagues seasons: %w", err)
 	}
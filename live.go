@@ -0,0 +1,378 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/0ffsideCompass/api-football-go-client/models"
+)
+
+// LiveEventKind is the kind of change a LiveWatcher reports.
+type LiveEventKind string
+
+// Kinds of LiveEvent.
+const (
+	// LiveKickoff is sent when a fixture starts appearing among the live
+	// fixtures.
+	LiveKickoff LiveEventKind = "kickoff"
+	// LiveGoal is sent for every new goal event.
+	LiveGoal LiveEventKind = "goal"
+	// LiveGoalCancelled is sent when a goal event the watcher reported has
+	// been withdrawn by the API, typically after a VAR review.
+	LiveGoalCancelled LiveEventKind = "goal_cancelled"
+	// LiveMissedPenalty is sent for a missed penalty, which the API reports
+	// as a goal event with the detail "Missed Penalty".
+	LiveMissedPenalty LiveEventKind = "missed_penalty"
+	// LiveCard is sent for every new card.
+	LiveCard LiveEventKind = "card"
+	// LiveSubstitution is sent for every new substitution.
+	LiveSubstitution LiveEventKind = "substitution"
+	// LiveVAR is sent for every new VAR decision.
+	LiveVAR LiveEventKind = "var"
+	// LiveStatusChange is sent when the short status of a fixture changes.
+	LiveStatusChange LiveEventKind = "status"
+	// LiveScoreChange is sent when the goals of a fixture change, in either
+	// direction.
+	LiveScoreChange LiveEventKind = "score"
+	// LiveElapsed is sent when the elapsed minutes of a fixture increase.
+	LiveElapsed LiveEventKind = "elapsed"
+	// LiveFullTime is sent when a fixture reaches a finished status (FT,
+	// AET or PEN).
+	LiveFullTime LiveEventKind = "full_time"
+	// LiveError is sent by Run when a poll fails. Polling continues.
+	LiveError LiveEventKind = "error"
+)
+
+// LiveEvent is a change in a live fixture.
+type LiveEvent struct {
+	Kind      LiveEventKind
+	FixtureID int
+	// Fixture is the state of the fixture after the change.
+	Fixture models.Fixture
+	// Previous is the state of the fixture before the change. It is the zero
+	// value for LiveKickoff.
+	Previous models.Fixture
+	// Event is the match event behind a LiveGoal, LiveGoalCancelled,
+	// LiveMissedPenalty, LiveCard, LiveSubstitution or LiveVAR. It is nil
+	// for the other kinds.
+	Event *models.Event
+	// Err is the error behind a LiveError.
+	Err error
+}
+
+// defaultLiveBuffer is the default capacity of a LiveWatcher's channel.
+const defaultLiveBuffer = 64
+
+// LiveWatcher polls the live fixtures and reports what changed between two
+// polls as LiveEvents. The match events of each fixture come with the live
+// fixtures themselves, so each poll costs a single request, plus one when
+// fixtures drop out of the live list and their final state is fetched.
+//
+// Fixtures that are already live on the first poll form the baseline: they
+// send no events for what happened before, and Fixtures returns their state.
+// Match events the API sends again are reported only once.
+type LiveWatcher struct {
+	client   *Client
+	interval time.Duration
+	params   map[string]any
+	reserve  int
+	events   chan LiveEvent
+
+	mu       sync.Mutex
+	running  bool
+	polled   bool
+	fixtures map[int]*liveFixture
+}
+
+// liveFixture is the last known state of a tracked fixture.
+type liveFixture struct {
+	fixture models.Fixture
+	events  []models.Event
+}
+
+// LiveWatcherOption configures a LiveWatcher.
+type LiveWatcherOption func(*LiveWatcher) error
+
+// WithLiveLeagues restricts the watcher to the live fixtures of the given
+// leagues. By default every live fixture is watched.
+func WithLiveLeagues(ids ...int) LiveWatcherOption {
+	return func(w *LiveWatcher) error {
+		if len(ids) == 0 {
+			return errors.New("no leagues given")
+		}
+		w.params["live"] = ids
+		return nil
+	}
+}
+
+// WithLiveQuotaReserve pauses polling until the daily quota resets (at
+// midnight UTC) once the API reports n or fewer requests left for the day,
+// keeping them for other uses of the key.
+func WithLiveQuotaReserve(n int) LiveWatcherOption {
+	return func(w *LiveWatcher) error {
+		if n <= 0 {
+			return errors.New("quota reserve must be positive")
+		}
+		w.reserve = n
+		return nil
+	}
+}
+
+// WithLiveBuffer sets the capacity of the Events channel. Run blocks when
+// the channel is full.
+func WithLiveBuffer(n int) LiveWatcherOption {
+	return func(w *LiveWatcher) error {
+		if n < 0 {
+			return errors.New("negative buffer size")
+		}
+		w.events = make(chan LiveEvent, n)
+		return nil
+	}
+}
+
+// NewLiveWatcher returns a LiveWatcher polling every interval.
+func (c *Client) NewLiveWatcher(interval time.Duration, opts ...LiveWatcherOption) (*LiveWatcher, error) {
+	if interval <= 0 {
+		return nil, errors.New("live watcher interval must be positive")
+	}
+	w := &LiveWatcher{
+		client:   c,
+		interval: interval,
+		params:   map[string]any{"live": "all"},
+		events:   make(chan LiveEvent, defaultLiveBuffer),
+		fixtures: make(map[int]*liveFixture),
+	}
+	for _, opt := range opts {
+		if err := opt(w); err != nil {
+			return nil, fmt.Errorf("error creating live watcher: %w", err)
+		}
+	}
+	return w, nil
+}
+
+// Events returns the channel Run sends events on. It is closed when Run
+// returns.
+func (w *LiveWatcher) Events() <-chan LiveEvent {
+	return w.events
+}
+
+// Run polls until ctx is done, sending the events of each poll on the
+// Events channel, and returns ctx's error. Failed polls are reported as
+// LiveError events. When the daily budget of the client's Limiter is
+// exhausted, or the quota reserve is reached, Run waits for the daily reset.
+// Run may only be called once.
+func (w *LiveWatcher) Run(ctx context.Context) error {
+	w.mu.Lock()
+	if w.running {
+		w.mu.Unlock()
+		return errors.New("live watcher already running")
+	}
+	w.running = true
+	w.mu.Unlock()
+	defer close(w.events)
+
+	return pollLoop(ctx, w.client, w.interval, w.reserve, w.events, w.Poll, func(err error) LiveEvent {
+		return LiveEvent{Kind: LiveError, Err: err}
+	})
+}
+
+// Poll fetches the live fixtures once and returns the changes since the
+// previous poll. Fixtures that dropped out of the live list are fetched by
+// id to report their final state. On error, the events gathered so far are
+// returned with it, and fixtures whose final state could not be fetched are
+// retried on the next poll. Poll must not be called concurrently with itself
+// or with Run.
+func (w *LiveWatcher) Poll(ctx context.Context) ([]LiveEvent, error) {
+	resp, err := w.client.FixtureContext(ctx, w.params)
+	if err != nil {
+		return nil, fmt.Errorf("error polling live fixtures: %w", err)
+	}
+
+	w.mu.Lock()
+	baseline := !w.polled
+	w.polled = true
+	var events []LiveEvent
+	live := make(map[int]bool, len(resp.Response))
+	for _, f := range resp.Response {
+		live[f.Fixture.ID] = true
+		events = append(events, w.apply(f, baseline)...)
+	}
+	var gone []int
+	for id := range w.fixtures {
+		if !live[id] {
+			gone = append(gone, id)
+		}
+	}
+	w.mu.Unlock()
+
+	sort.Ints(gone)
+	if len(gone) > 0 {
+		final, missing, err := w.client.FixturesByIDs(ctx, gone)
+		if err != nil {
+			return events, fmt.Errorf("error fetching finished live fixtures: %w", err)
+		}
+
+		w.mu.Lock()
+		for _, f := range final {
+			events = append(events, w.apply(f, false)...)
+		}
+		// Fixtures the API no longer returns at all are dropped.
+		for _, id := range missing {
+			delete(w.fixtures, id)
+		}
+		w.mu.Unlock()
+	}
+	return events, nil
+}
+
+// apply records the new state of a fixture and returns the changes. Fixtures
+// that are no longer live after the update stop being tracked. w.mu must be
+// held.
+func (w *LiveWatcher) apply(f models.FixtureDetails, baseline bool) []LiveEvent {
+	id := f.Fixture.ID
+	next := &liveFixture{fixture: f.Summary(), events: f.Events}
+	prev, tracked := w.fixtures[id]
+	if next.fixture.Fixture.Status.Short.IsLive() {
+		w.fixtures[id] = next
+	} else {
+		delete(w.fixtures, id)
+	}
+	if baseline {
+		return nil
+	}
+
+	var events []LiveEvent
+	if !tracked {
+		if !next.fixture.Fixture.Status.Short.IsLive() {
+			// A fixture that is not live and was never tracked has nothing
+			// to report.
+			return nil
+		}
+		events = append(events, LiveEvent{Kind: LiveKickoff, FixtureID: id, Fixture: next.fixture})
+		prev = &liveFixture{}
+	}
+	return append(events, diffLiveFixture(id, prev, next)...)
+}
+
+// diffLiveFixture returns the changes between two states of a fixture.
+func diffLiveFixture(id int, prev, next *liveFixture) []LiveEvent {
+	var events []LiveEvent
+	change := func(kind LiveEventKind, event *models.Event) {
+		events = append(events, LiveEvent{
+			Kind:      kind,
+			FixtureID: id,
+			Fixture:   next.fixture,
+			Previous:  prev.fixture,
+			Event:     event,
+		})
+	}
+
+	before, after := prev.fixture.Fixture.Status, next.fixture.Fixture.Status
+	if before.Short != after.Short && before.Short != "" {
+		change(LiveStatusChange, nil)
+	}
+
+	added, removed := diffMatchEvents(prev.events, next.events)
+	for i := range added {
+		if kind, ok := matchEventKind(added[i]); ok {
+			change(kind, &added[i])
+		}
+	}
+	for i := range removed {
+		if kind, _ := matchEventKind(removed[i]); kind == LiveGoal {
+			change(LiveGoalCancelled, &removed[i])
+		}
+	}
+
+	if prev.fixture.Goals != next.fixture.Goals {
+		change(LiveScoreChange, nil)
+	}
+	if after.Elapsed > before.Elapsed {
+		change(LiveElapsed, nil)
+	}
+	if after.Short.IsFinished() && before.Short != after.Short {
+		change(LiveFullTime, nil)
+	}
+	return events
+}
+
+// diffMatchEvents returns the events of next missing from prev, and those of
+// prev missing from next, in their original order. The API sends no event
+// IDs, so events are matched on their minute, team, type and detail, and
+// counted so that identical events in the same minute are kept apart.
+// Players are left out of the match since the API sometimes fills them in
+// later.
+func diffMatchEvents(prev, next []models.Event) (added, removed []models.Event) {
+	prevCount := make(map[string]int, len(prev))
+	for _, e := range prev {
+		prevCount[matchEventKey(e)]++
+	}
+	nextCount := make(map[string]int, len(next))
+	for _, e := range next {
+		nextCount[matchEventKey(e)]++
+	}
+
+	seen := make(map[string]int, len(next))
+	for _, e := range next {
+		key := matchEventKey(e)
+		seen[key]++
+		if seen[key] > prevCount[key] {
+			added = append(added, e)
+		}
+	}
+	clear(seen)
+	for _, e := range prev {
+		key := matchEventKey(e)
+		seen[key]++
+		if seen[key] > nextCount[key] {
+			removed = append(removed, e)
+		}
+	}
+	return added, removed
+}
+
+// matchEventKey identifies an event for diffMatchEvents.
+func matchEventKey(e models.Event) string {
+	return strconv.Itoa(e.Time.Elapsed) + "+" + string(e.Time.Extra) + "|" +
+		strconv.Itoa(e.Team.ID) + "|" + e.Type + "|" + e.Detail
+}
+
+// matchEventKind maps an event's type to the kind of LiveEvent it causes.
+func matchEventKind(e models.Event) (LiveEventKind, bool) {
+	switch e.Type {
+	case "Goal":
+		if e.Detail == "Missed Penalty" {
+			return LiveMissedPenalty, true
+		}
+		return LiveGoal, true
+	case "Card":
+		return LiveCard, true
+	case "subst":
+		return LiveSubstitution, true
+	case "Var":
+		return LiveVAR, true
+	default:
+		return "", false
+	}
+}
+
+// Fixtures returns the last known state of the fixtures being watched,
+// ordered by id.
+func (w *LiveWatcher) Fixtures() []models.Fixture {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	fixtures := make([]models.Fixture, 0, len(w.fixtures))
+	for _, state := range w.fixtures {
+		fixtures = append(fixtures, state.fixture)
+	}
+	sort.Slice(fixtures, func(i, j int) bool {
+		return fixtures[i].Fixture.ID < fixtures[j].Fixture.ID
+	})
+	return fixtures
+}
@@ -0,0 +1,183 @@
+package client_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	client "github.com/0ffsideCompass/api-football-go-client"
+)
+
+// liveFixture renders a fixture of the live list, with its events.
+func liveFixture(id int, status string, elapsed, home, away int, events ...string) string {
+	return fmt.Sprintf(`{
+		"fixture": {"id": %d, "status": {"short": %q, "elapsed": %d}},
+		"teams": {"home": {"id": 33}, "away": {"id": 34}},
+		"goals": {"home": %d, "away": %d},
+		"events": [%s]
+	}`, id, status, elapsed, home, away, strings.Join(events, ","))
+}
+
+// liveEvent renders a match event.
+func liveEvent(elapsed, team int, typ, detail, player string) string {
+	return fmt.Sprintf(`{"time": {"elapsed": %d, "extra": null}, "team": {"id": %d},
+		"player": {"id": 1, "name": %q}, "type": %q, "detail": %q}`, elapsed, team, player, typ, detail)
+}
+
+func liveBody(fixtures ...string) string {
+	return `{"errors": [], "results": 1, "response": [` + strings.Join(fixtures, ",") + `]}`
+}
+
+func newLiveWatcher(t *testing.T, bodies ...string) (*client.LiveWatcher, *MockHTTPClient) {
+	t.Helper()
+	var n int
+	httpClient := &MockHTTPClient{Respond: respondOK(func(*http.Request) string {
+		body := bodies[min(n, len(bodies)-1)]
+		n++
+		return body
+	})}
+	apiClient, err := client.New("test-key", httpClient)
+	assert.NoError(t, err)
+	watcher, err := apiClient.NewLiveWatcher(time.Millisecond)
+	assert.NoError(t, err)
+	return watcher, httpClient
+}
+
+func kinds(events []client.LiveEvent) []client.LiveEventKind {
+	var out []client.LiveEventKind
+	for _, ev := range events {
+		out = append(out, ev.Kind)
+	}
+	return out
+}
+
+func TestLiveWatcherReportsChanges(t *testing.T) {
+	goal := liveEvent(12, 33, "Goal", "Normal Goal", "Rashford")
+	card := liveEvent(30, 34, "Card", "Yellow Card", "Rice")
+	watcher, httpClient := newLiveWatcher(t,
+		liveBody(liveFixture(1, "1H", 10, 0, 0)),
+		liveBody(liveFixture(1, "1H", 31, 1, 0, goal, card)),
+		// The API re-sends the same events, and sometimes fills players in
+		// late.
+		liveBody(liveFixture(1, "HT", 45, 1, 0, goal, liveEvent(30, 34, "Card", "Yellow Card", "D. Rice")),
+			liveFixture(2, "1H", 1, 0, 0)),
+	)
+	ctx := context.Background()
+
+	events, err := watcher.Poll(ctx)
+	assert.NoError(t, err)
+	assert.Empty(t, events, "fixtures live on the first poll are the baseline")
+	assert.Len(t, watcher.Fixtures(), 1)
+	assert.Equal(t, "https://v3.football.api-sports.io/fixtures?live=all", httpClient.URLs[0])
+
+	events, err = watcher.Poll(ctx)
+	assert.NoError(t, err)
+	assert.Equal(t, []client.LiveEventKind{client.LiveGoal, client.LiveCard, client.LiveScoreChange, client.LiveElapsed}, kinds(events))
+	assert.Equal(t, "Rashford", events[0].Event.Player.Name)
+	assert.Equal(t, 1, events[2].Fixture.Goals.Home)
+	assert.Equal(t, 0, events[2].Previous.Goals.Home)
+
+	events, err = watcher.Poll(ctx)
+	assert.NoError(t, err)
+	assert.Equal(t, []client.LiveEventKind{client.LiveStatusChange, client.LiveElapsed, client.LiveKickoff, client.LiveElapsed}, kinds(events))
+	assert.Equal(t, 2, events[2].FixtureID)
+	assert.Len(t, watcher.Fixtures(), 2)
+}
+
+func TestLiveWatcherCancelledGoal(t *testing.T) {
+	goal := liveEvent(55, 33, "Goal", "Normal Goal", "Hojlund")
+	watcher, _ := newLiveWatcher(t,
+		liveBody(liveFixture(1, "2H", 54, 0, 0)),
+		liveBody(liveFixture(1, "2H", 55, 1, 0, goal)),
+		liveBody(liveFixture(1, "2H", 57, 0, 0, liveEvent(57, 33, "Var", "Goal cancelled", "Hojlund"))),
+	)
+	ctx := context.Background()
+
+	for range 2 {
+		_, err := watcher.Poll(ctx)
+		assert.NoError(t, err)
+	}
+	events, err := watcher.Poll(ctx)
+	assert.NoError(t, err)
+	assert.Equal(t,
+		[]client.LiveEventKind{client.LiveVAR, client.LiveGoalCancelled, client.LiveScoreChange, client.LiveElapsed},
+		kinds(events),
+	)
+	assert.Equal(t, 55, events[1].Event.Time.Elapsed)
+	assert.Equal(t, 0, events[2].Fixture.Goals.Home)
+}
+
+func TestLiveWatcherFullTime(t *testing.T) {
+	var polls int
+	httpClient := &MockHTTPClient{Respond: respondOK(func(req *http.Request) string {
+		if req.URL.Query().Get("ids") != "" {
+			return liveBody(liveFixture(1, "FT", 90, 2, 1))
+		}
+		polls++
+		if polls == 1 {
+			return liveBody(liveFixture(1, "2H", 88, 2, 1))
+		}
+		return liveBody()
+	})}
+	apiClient, err := client.New("test-key", httpClient)
+	assert.NoError(t, err)
+	watcher, err := apiClient.NewLiveWatcher(time.Minute, client.WithLiveLeagues(39, 61))
+	assert.NoError(t, err)
+	ctx := context.Background()
+
+	_, err = watcher.Poll(ctx)
+	assert.NoError(t, err)
+	events, err := watcher.Poll(ctx)
+	assert.NoError(t, err)
+	assert.Equal(t, []client.LiveEventKind{client.LiveStatusChange, client.LiveElapsed, client.LiveFullTime}, kinds(events))
+	assert.Empty(t, watcher.Fixtures())
+	assert.Equal(t, []string{
+		"https://v3.football.api-sports.io/fixtures?live=39-61",
+		"https://v3.football.api-sports.io/fixtures?live=39-61",
+		"https://v3.football.api-sports.io/fixtures?ids=1",
+	}, httpClient.URLs)
+}
+
+func TestLiveWatcherRun(t *testing.T) {
+	watcher, _ := newLiveWatcher(t,
+		liveBody(),
+		`{"errors": {"requests": "You have reached the request limit for the day"}, "results": 0, "response": []}`,
+		liveBody(liveFixture(7, "1H", 2, 0, 0)),
+	)
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan error, 1)
+	go func() { done <- watcher.Run(ctx) }()
+
+	ev := <-watcher.Events()
+	assert.Equal(t, client.LiveError, ev.Kind)
+	assert.ErrorIs(t, ev.Err, client.ErrQuotaExceeded)
+
+	ev = <-watcher.Events()
+	assert.Equal(t, client.LiveKickoff, ev.Kind)
+	assert.Equal(t, 7, ev.FixtureID)
+
+	cancel()
+	assert.ErrorIs(t, <-done, context.Canceled)
+	for range watcher.Events() {
+	}
+	assert.EqualError(t, watcher.Run(context.Background()), "live watcher already running")
+}
+
+func TestLiveWatcherOptions(t *testing.T) {
+	apiClient, err := client.New("test-key", newMockClient())
+	assert.NoError(t, err)
+
+	_, err = apiClient.NewLiveWatcher(0)
+	assert.EqualError(t, err, "live watcher interval must be positive")
+	_, err = apiClient.NewLiveWatcher(time.Second, client.WithLiveLeagues())
+	assert.EqualError(t, err, "error creating live watcher: no leagues given")
+	_, err = apiClient.NewLiveWatcher(time.Second, client.WithLiveQuotaReserve(0))
+	assert.EqualError(t, err, "error creating live watcher: quota reserve must be positive")
+	_, err = apiClient.NewLiveWatcher(time.Second, client.WithLiveBuffer(-1))
+	assert.EqualError(t, err, "error creating live watcher: negative buffer size")
+}
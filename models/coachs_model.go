@@ -2,39 +2,25 @@ package models
 
 // Coachs is the response from the /coachs endpoint
 type Coachs struct {
-	Get        string     `json:"get"`
-	Parameters any        `json:"parameters"`
-	Errors     any        `json:"errors"`
-	Results    int        `json:"results"`
-	Paging     Pagination `json:"paging"`
-	Response   []struct {
-		ID        int    `json:"id"`
-		Name      string `json:"name"`
-		Firstname string `json:"firstname"`
-		Lastname  string `json:"lastname"`
-		Age       int    `json:"age"`
-		Birth     struct {
-			Date    string `json:"date"`
-			Place   string `json:"place"`
-			Country string `json:"country"`
-		} `json:"birth"`
-		Nationality string `json:"nationality"`
-		Height      string `json:"height"`
-		Weight      string `json:"weight"`
-		Photo       string `json:"photo"`
-		Team        struct {
-			ID   int    `json:"id"`
-			Name string `json:"name"`
-			Logo string `json:"logo"`
-		} `json:"team"`
-		Career []struct {
-			Team struct {
-				ID   int    `json:"id"`
-				Name string `json:"name"`
-				Logo string `json:"logo"`
-			} `json:"team"`
-			Start string `json:"start"`
-			End   string `json:"end"`
-		} `json:"career"`
-	} `json:"response"`
+	Envelope[[]Coach]
+}
+
+// Coach is the profile of a coach, their current team and career.
+type Coach struct {
+	ID          int     `json:"id"`
+	Name        string  `json:"name"`
+	Firstname   string  `json:"firstname"`
+	Lastname    string  `json:"lastname"`
+	Age         int     `json:"age"`
+	Birth       Birth   `json:"birth"`
+	Nationality string  `json:"nationality"`
+	Height      string  `json:"height"`
+	Weight      string  `json:"weight"`
+	Photo       string  `json:"photo"`
+	Team        TeamRef `json:"team"`
+	Career      []struct {
+		Team  TeamRef `json:"team"`
+		Start string  `json:"start"`
+		End   string  `json:"end"`
+	} `json:"career"`
 }
@@ -1,7 +1,11 @@
 // Package models contains the data structures for API responses from the football API.
 package models
 
-import "encoding/json"
+import (
+	"encoding/json"
+	"strconv"
+	"strings"
+)
 
 // FlexString is a string that also accepts JSON numbers and null when
 // unmarshalling. API-Football mixes value types in statistics fields — the
@@ -31,12 +35,51 @@ func (f *FlexString) UnmarshalJSON(data []byte) error {
 	return nil
 }
 
+// The numeric accessors below tell a missing value apart from a zero one:
+// "0" yields (0, true), while null or "" yields (0, false). ok is also false
+// when the value is not a number; use IsNull to tell the two cases apart.
+
+// IsNull reports whether the value is missing, that is the API sent null or
+// an empty string.
+func (f FlexString) IsNull() bool {
+	return strings.TrimSpace(string(f)) == ""
+}
+
+// Int returns the value as an integer.
+func (f FlexString) Int() (int, bool) {
+	n, err := strconv.Atoi(strings.TrimSpace(string(f)))
+	return n, err == nil
+}
+
+// Float returns the value as a float.
+func (f FlexString) Float() (float64, bool) {
+	v, err := strconv.ParseFloat(strings.TrimSpace(string(f)), 64)
+	return v, err == nil
+}
+
+// Percent returns a percentage such as "45%" as 45. The percent sign is
+// optional.
+func (f FlexString) Percent() (float64, bool) {
+	return FlexString(strings.TrimSuffix(strings.TrimSpace(string(f)), "%")).Float()
+}
+
+// Decimal returns the value as an exact Decimal, for odds.
+func (f FlexString) Decimal() (Decimal, bool) {
+	d, err := ParseDecimal(strings.TrimSpace(string(f)))
+	return d, err == nil
+}
+
 // MinuteStat represents statistics for a specific minute range
 type MinuteStat struct {
 	Total      int    `json:"total"`
 	Percentage string `json:"percentage"`
 }
 
+// Percent returns Percentage as a number, 45 for "45%".
+func (m MinuteStat) Percent() (float64, bool) {
+	return FlexString(m.Percentage).Percent()
+}
+
 // MinuteBreakdown represents statistics broken down by 15-minute intervals
 type MinuteBreakdown struct {
 	Zero15   MinuteStat `json:"0-15"`
@@ -63,8 +106,84 @@ type HomeAwayString struct {
 	Total string `json:"total"`
 }
 
+// The accessors below return an average such as "1.5" or a percentage such
+// as "45%" as a number, dropping the percent sign. ok is false for a missing
+// value or one that is not a number, such as a score.
+
+// HomeValue returns Home as a number.
+func (h HomeAwayString) HomeValue() (float64, bool) {
+	return FlexString(h.Home).Percent()
+}
+
+// AwayValue returns Away as a number.
+func (h HomeAwayString) AwayValue() (float64, bool) {
+	return FlexString(h.Away).Percent()
+}
+
+// TotalValue returns Total as a number.
+func (h HomeAwayString) TotalValue() (float64, bool) {
+	return FlexString(h.Total).Percent()
+}
+
 // Pagination represents the paging information in API responses
 type Pagination struct {
 	Current int `json:"current"`
 	Total   int `json:"total"`
 }
+
+// APIErrors holds the messages API-Football reports in a response's "errors"
+// field, keyed by the parameter or subsystem they refer to (for example
+// "token", "requests", or "season"). The API sends an empty array when there
+// are no errors and an object when there are; both shapes decode into this
+// type, as do arrays of objects or plain strings. Plain strings are keyed by
+// their position in the array.
+type APIErrors map[string]string
+
+// UnmarshalJSON accepts an object, an array, or null.
+func (e *APIErrors) UnmarshalJSON(data []byte) error {
+	errs := APIErrors{}
+	switch {
+	case string(data) == "null":
+	case len(data) > 0 && data[0] == '[':
+		var items []json.RawMessage
+		if err := json.Unmarshal(data, &items); err != nil {
+			return err
+		}
+		for i, item := range items {
+			if len(item) > 0 && item[0] == '{' {
+				if err := errs.merge(item); err != nil {
+					return err
+				}
+				continue
+			}
+			var msg FlexString
+			if err := json.Unmarshal(item, &msg); err != nil {
+				return err
+			}
+			errs[strconv.Itoa(i)] = string(msg)
+		}
+	default:
+		if err := errs.merge(data); err != nil {
+			return err
+		}
+	}
+	*e = errs
+	return nil
+}
+
+// merge decodes a JSON object of messages into e. Values that are not
+// strings keep their JSON form.
+func (e APIErrors) merge(data []byte) error {
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return err
+	}
+	for key, raw := range fields {
+		var msg string
+		if err := json.Unmarshal(raw, &msg); err != nil {
+			msg = string(raw)
+		}
+		e[key] = msg
+	}
+	return nil
+}
@@ -0,0 +1,127 @@
+package models
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+)
+
+// decimalDigits is the number of fractional digits a Decimal holds.
+const decimalDigits = 6
+
+// decimalScale is 10^decimalDigits.
+const decimalScale = 1_000_000
+
+// Decimal is an exact decimal number with up to six fractional digits. It
+// holds odds as the API quotes them ("1.85", "2.375"), so they can be compared
+// and printed without float rounding. The zero value is 0.
+type Decimal struct {
+	units int64 // the value times decimalScale
+}
+
+// ParseDecimal parses a decimal number such as "1.85" or "-0.25".
+func ParseDecimal(s string) (Decimal, error) {
+	text := s
+	neg := false
+	if rest, ok := strings.CutPrefix(text, "-"); ok {
+		neg, text = true, rest
+	} else {
+		text = strings.TrimPrefix(text, "+")
+	}
+	whole, frac, _ := strings.Cut(text, ".")
+	if whole == "" && frac == "" || !allDigits(whole) || !allDigits(frac) {
+		return Decimal{}, fmt.Errorf("invalid decimal %q", s)
+	}
+	if len(frac) > decimalDigits {
+		return Decimal{}, fmt.Errorf("decimal %q has more than %d fractional digits", s, decimalDigits)
+	}
+
+	var units int64
+	if whole != "" {
+		n, err := strconv.ParseInt(whole, 10, 64)
+		if err != nil || n > math.MaxInt64/decimalScale {
+			return Decimal{}, fmt.Errorf("decimal %q out of range", s)
+		}
+		units = n * decimalScale
+	}
+	if frac != "" {
+		n, _ := strconv.ParseInt(frac+strings.Repeat("0", decimalDigits-len(frac)), 10, 64)
+		if units > math.MaxInt64-n {
+			return Decimal{}, fmt.Errorf("decimal %q out of range", s)
+		}
+		units += n
+	}
+	if neg {
+		units = -units
+	}
+	return Decimal{units: units}, nil
+}
+
+// allDigits reports whether s holds only ASCII digits.
+func allDigits(s string) bool {
+	for _, r := range s {
+		if r < '0' || r > '9' {
+			return false
+		}
+	}
+	return true
+}
+
+// String formats d without trailing zeros, for example "1.85" or "3".
+func (d Decimal) String() string {
+	units := d.units
+	sign := ""
+	if units < 0 {
+		sign, units = "-", -units
+	}
+	whole, frac := units/decimalScale, units%decimalScale
+	if frac == 0 {
+		return sign + strconv.FormatInt(whole, 10)
+	}
+	digits := strings.TrimRight(fmt.Sprintf("%0*d", decimalDigits, frac), "0")
+	return sign + strconv.FormatInt(whole, 10) + "." + digits
+}
+
+// Float64 returns d as a float, for arithmetic.
+func (d Decimal) Float64() float64 {
+	return float64(d.units) / decimalScale
+}
+
+// IsZero reports whether d is 0.
+func (d Decimal) IsZero() bool {
+	return d.units == 0
+}
+
+// Sub returns d - other.
+func (d Decimal) Sub(other Decimal) Decimal {
+	return Decimal{units: d.units - other.units}
+}
+
+// Cmp returns -1, 0 or +1 depending on whether d is less than, equal to or
+// greater than other.
+func (d Decimal) Cmp(other Decimal) int {
+	switch {
+	case d.units < other.units:
+		return -1
+	case d.units > other.units:
+		return 1
+	default:
+		return 0
+	}
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (d Decimal) MarshalText() ([]byte, error) {
+	return []byte(d.String()), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (d *Decimal) UnmarshalText(text []byte) error {
+	parsed, err := ParseDecimal(string(text))
+	if err != nil {
+		return err
+	}
+	*d = parsed
+	return nil
+}
@@ -0,0 +1,58 @@
+package models
+
+import "encoding/json"
+
+// Envelope is the body every API-Football endpoint wraps its data in. T is
+// the type of the response field: a slice for most endpoints, an object for
+// /teams/statistics.
+type Envelope[T any] struct {
+	Get        string     `json:"get"`
+	Parameters Parameters `json:"parameters"`
+	Errors     APIErrors  `json:"errors"`
+	Results    int        `json:"results"`
+	Paging     Pagination `json:"paging"`
+	Response   T          `json:"response"`
+}
+
+// IsEmpty reports whether the response holds no results.
+func (e Envelope[T]) IsEmpty() bool {
+	return e.Results == 0
+}
+
+// Err returns the errors reported in the body as an *APIError, or nil if
+// there are none.
+func (e Envelope[T]) Err() error {
+	if len(e.Errors) == 0 {
+		return nil
+	}
+	return &APIError{Errors: e.Errors}
+}
+
+// Page returns the response together with its pagination.
+func (e Envelope[T]) Page() (T, Pagination) {
+	return e.Response, e.Paging
+}
+
+// Parameters holds the query parameters the API echoes back, as strings.
+// The API sends an empty array when there are none.
+type Parameters map[string]string
+
+// UnmarshalJSON accepts an object, an array, or null.
+func (p *Parameters) UnmarshalJSON(data []byte) error {
+	params := Parameters{}
+	if len(data) > 0 && data[0] == '{' {
+		var fields map[string]json.RawMessage
+		if err := json.Unmarshal(data, &fields); err != nil {
+			return err
+		}
+		for key, raw := range fields {
+			var value FlexString
+			if err := json.Unmarshal(raw, &value); err != nil {
+				value = FlexString(raw)
+			}
+			params[key] = string(value)
+		}
+	}
+	*p = params
+	return nil
+}
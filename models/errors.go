@@ -0,0 +1,78 @@
+package models
+
+import (
+	"errors"
+	"sort"
+	"strings"
+)
+
+// Sentinel errors for the categories of failure API-Football reports inside
+// a 200 OK body. An *APIError matches every category any of its messages
+// belongs to, so callers can test for them with errors.Is:
+//
+//	if errors.Is(err, models.ErrQuotaExceeded) {
+//		// back off until the quota resets
+//	}
+var (
+	// ErrQuotaExceeded reports that the daily or per-minute request quota
+	// is exhausted.
+	ErrQuotaExceeded = errors.New("quota exceeded")
+	// ErrAuth reports a missing, invalid, or suspended API key.
+	ErrAuth = errors.New("authentication failed")
+	// ErrInvalidParameter reports a rejected query parameter or parameter
+	// combination.
+	ErrInvalidParameter = errors.New("invalid parameter")
+)
+
+// Error keys API-Football uses for quota and authentication failures. Every
+// other key names the offending parameter.
+var (
+	quotaErrorKeys = map[string]bool{"requests": true, "rateLimit": true}
+	authErrorKeys  = map[string]bool{"token": true, "access": true}
+)
+
+// APIError is returned when API-Football answers with a 200 OK whose
+// "errors" field is not empty. Errors maps each key the API reported (a
+// parameter name such as "season", or "token", "requests", ...) to its
+// message.
+type APIError struct {
+	Errors APIErrors
+}
+
+// Error lists the reported messages in key order.
+func (e *APIError) Error() string {
+	keys := make([]string, 0, len(e.Errors))
+	for key := range e.Errors {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	msgs := make([]string, 0, len(keys))
+	for _, key := range keys {
+		msgs = append(msgs, key+": "+e.Errors[key])
+	}
+	return "api error: " + strings.Join(msgs, "; ")
+}
+
+// Is reports whether any of the messages belongs to the target category
+// (ErrQuotaExceeded, ErrAuth or ErrInvalidParameter).
+func (e *APIError) Is(target error) bool {
+	for key := range e.Errors {
+		if errorCategory(key) == target {
+			return true
+		}
+	}
+	return false
+}
+
+// errorCategory maps an error key to its sentinel error.
+func errorCategory(key string) error {
+	switch {
+	case quotaErrorKeys[key]:
+		return ErrQuotaExceeded
+	case authErrorKeys[key]:
+		return ErrAuth
+	default:
+		return ErrInvalidParameter
+	}
+}
@@ -4,165 +4,88 @@ import "time"
 
 // FixtureHeadToHeadResp is the response from the /fixtures/headtohead endpoint.
 type FixtureHeadToHeadResp struct {
-	Get        string `json:"get"`
-	Parameters struct {
-		H2H string `json:"h2h"`
-	} `json:"parameters"`
-	Errors   []any         `json:"errors"`
-	Results  int           `json:"results"`
-	Paging   Pagination    `json:"paging"`
-	Response []FixtureResp `json:"response"`
+	Envelope[[]Fixture]
 }
 
-// FixtureResp is a single fixture entry as returned by the fixtures endpoints.
-type FixtureResp struct {
-	Fixture struct {
-		ID        int       `json:"id"`
-		Referee   string    `json:"referee"`
-		Timezone  string    `json:"timezone"`
-		Date      time.Time `json:"date"`
-		Timestamp int       `json:"timestamp"`
-		Periods   struct {
-			First  int `json:"first"`
-			Second int `json:"second"`
-		} `json:"periods"`
-		Venue struct {
-			ID   int    `json:"id"`
-			Name string `json:"name"`
-			City string `json:"city"`
-		} `json:"venue"`
-		Status struct {
-			Long    string `json:"long"`
-			Short   string `json:"short"`
-			Elapsed int    `json:"elapsed"`
-		} `json:"status"`
-	} `json:"fixture"`
-	League struct {
-		ID      int    `json:"id"`
-		Name    string `json:"name"`
-		Country string `json:"country"`
-		Logo    string `json:"logo"`
-		Flag    string `json:"flag"`
-		Season  int    `json:"season"`
-		Round   string `json:"round"`
-	} `json:"league"`
-	Teams struct {
-		Home struct {
-			ID     int    `json:"id"`
-			Name   string `json:"name"`
-			Logo   string `json:"logo"`
-			Winner bool   `json:"winner"`
-		} `json:"home"`
-		Away struct {
-			ID     int    `json:"id"`
-			Name   string `json:"name"`
-			Logo   string `json:"logo"`
-			Winner bool   `json:"winner"`
-		} `json:"away"`
-	} `json:"teams"`
-	Goals struct {
-		Home int `json:"home"`
-		Away int `json:"away"`
-	} `json:"goals"`
-	Score struct {
-		Halftime struct {
-			Home int `json:"home"`
-			Away int `json:"away"`
-		} `json:"halftime"`
-		Fulltime struct {
-			Home int `json:"home"`
-			Away int `json:"away"`
-		} `json:"fulltime"`
-		Extratime struct {
-			Home any `json:"home"`
-			Away any `json:"away"`
-		} `json:"extratime"`
-		Penalty struct {
-			Home any `json:"home"`
-			Away any `json:"away"`
-		} `json:"penalty"`
-	} `json:"score"`
+// Fixture is a single fixture entry as returned by the fixtures endpoints:
+// the fixture itself, its league, teams, goals and score.
+type Fixture struct {
+	Fixture FixtureInfo   `json:"fixture"`
+	League  FixtureLeague `json:"league"`
+	Teams   FixtureTeams  `json:"teams"`
+	Goals   Goals         `json:"goals"`
+	Score   Score         `json:"score"`
+}
+
+// FixtureResp is an alias of Fixture, kept for compatibility.
+type FixtureResp = Fixture
+
+// FixtureInfo describes when, where and in which state a fixture is played.
+type FixtureInfo struct {
+	ID        int       `json:"id"`
+	Referee   string    `json:"referee"`
+	Timezone  string    `json:"timezone"`
+	Date      time.Time `json:"date"`
+	Timestamp int       `json:"timestamp"`
+	Periods   Periods   `json:"periods"`
+	Venue     VenueRef  `json:"venue"`
+	Status    Status    `json:"status"`
+}
+
+// Periods holds the start timestamps of each half of a fixture.
+type Periods struct {
+	First  int `json:"first"`
+	Second int `json:"second"`
+}
+
+// Status is the status of a fixture and the minutes elapsed.
+type Status struct {
+	Long    string        `json:"long"`
+	Short   FixtureStatus `json:"short"`
+	Elapsed int           `json:"elapsed"`
+}
+
+// FixtureLeague is the league of a fixture, including its round.
+type FixtureLeague struct {
+	LeagueRef
+	Round string `json:"round"`
+}
+
+// FixtureTeams holds the home and away teams of a fixture.
+type FixtureTeams struct {
+	Home FixtureTeam `json:"home"`
+	Away FixtureTeam `json:"away"`
+}
+
+// FixtureTeam is a team playing a fixture, and whether it won.
+type FixtureTeam struct {
+	TeamRef
+	Winner bool `json:"winner"`
+}
+
+// Goals holds a goal count per side.
+type Goals struct {
+	Home int `json:"home"`
+	Away int `json:"away"`
+}
+
+// OptionalGoals holds a goal count per side for periods that are not
+// always played. The counts are nil when the period was not played.
+type OptionalGoals struct {
+	Home *int `json:"home"`
+	Away *int `json:"away"`
+}
+
+// Score is the score of a fixture at the end of each period.
+type Score struct {
+	Halftime  Goals         `json:"halftime"`
+	Fulltime  Goals         `json:"fulltime"`
+	Extratime OptionalGoals `json:"extratime"`
+	Penalty   OptionalGoals `json:"penalty"`
 }
 
 // FixturesByDateResp is the response from the /fixtures endpoint when
 // querying by league, season, and date range.
 type FixturesByDateResp struct {
-	Get        string `json:"get"`
-	Parameters struct {
-		From   string `json:"from"`
-		League string `json:"league"`
-		Season string `json:"season"`
-		To     string `json:"to"`
-	} `json:"parameters"`
-	Errors   any        `json:"errors"`
-	Results  int        `json:"results"`
-	Paging   Pagination `json:"paging"`
-	Response []struct {
-		Fixture struct {
-			ID        int       `json:"id"`
-			Referee   string    `json:"referee"`
-			Timezone  string    `json:"timezone"`
-			Date      time.Time `json:"date"`
-			Timestamp int       `json:"timestamp"`
-			Periods   struct {
-				First  any `json:"first"`
-				Second any `json:"second"`
-			} `json:"periods"`
-			Venue struct {
-				ID   int    `json:"id"`
-				Name string `json:"name"`
-				City string `json:"city"`
-			} `json:"venue"`
-			Status struct {
-				Long    string `json:"long"`
-				Short   string `json:"short"`
-				Elapsed any    `json:"elapsed"`
-			} `json:"status"`
-		} `json:"fixture"`
-		League struct {
-			ID      int    `json:"id"`
-			Name    string `json:"name"`
-			Country string `json:"country"`
-			Logo    string `json:"logo"`
-			Flag    string `json:"flag"`
-			Season  int    `json:"season"`
-			Round   string `json:"round"`
-		} `json:"league"`
-		Teams struct {
-			Home struct {
-				ID     int    `json:"id"`
-				Name   string `json:"name"`
-				Logo   string `json:"logo"`
-				Winner any    `json:"winner"`
-			} `json:"home"`
-			Away struct {
-				ID     int    `json:"id"`
-				Name   string `json:"name"`
-				Logo   string `json:"logo"`
-				Winner any    `json:"winner"`
-			} `json:"away"`
-		} `json:"teams"`
-		Goals struct {
-			Home any `json:"home"`
-			Away any `json:"away"`
-		} `json:"goals"`
-		Score struct {
-			Halftime struct {
-				Home any `json:"home"`
-				Away any `json:"away"`
-			} `json:"halftime"`
-			Fulltime struct {
-				Home any `json:"home"`
-				Away any `json:"away"`
-			} `json:"fulltime"`
-			Extratime struct {
-				Home any `json:"home"`
-				Away any `json:"away"`
-			} `json:"extratime"`
-			Penalty struct {
-				Home any `json:"home"`
-				Away any `json:"away"`
-			} `json:"penalty"`
-		} `json:"score"`
-	} `json:"response"`
+	Envelope[[]Fixture]
 }
@@ -0,0 +1,161 @@
+package models
+
+import (
+	"fmt"
+	"strings"
+)
+
+// FixtureStatus is the short status code of a fixture, as found in the
+// "status.short" field of fixture responses and accepted by the 'status'
+// parameter of the fixtures endpoints.
+type FixtureStatus string
+
+// The fixture statuses documented by API-Football.
+const (
+	StatusTimeToBeDefined    FixtureStatus = "TBD"
+	StatusNotStarted         FixtureStatus = "NS"
+	StatusFirstHalf          FixtureStatus = "1H"
+	StatusHalftime           FixtureStatus = "HT"
+	StatusSecondHalf         FixtureStatus = "2H"
+	StatusExtraTime          FixtureStatus = "ET"
+	StatusBreakTime          FixtureStatus = "BT"
+	StatusPenaltyInProgress  FixtureStatus = "P"
+	StatusSuspended          FixtureStatus = "SUSP"
+	StatusInterrupted        FixtureStatus = "INT"
+	StatusFinished           FixtureStatus = "FT"
+	StatusFinishedAfterExtra FixtureStatus = "AET"
+	StatusFinishedAfterPens  FixtureStatus = "PEN"
+	StatusPostponed          FixtureStatus = "PST"
+	StatusCancelled          FixtureStatus = "CANC"
+	StatusAbandoned          FixtureStatus = "ABD"
+	StatusTechnicalLoss      FixtureStatus = "AWD"
+	StatusWalkOver           FixtureStatus = "WO"
+	StatusLive               FixtureStatus = "LIVE"
+)
+
+// statusLongNames maps each status to the long name the API sends in
+// "status.long".
+var statusLongNames = map[FixtureStatus]string{
+	StatusTimeToBeDefined:    "Time To Be Defined",
+	StatusNotStarted:         "Not Started",
+	StatusFirstHalf:          "First Half, Kick Off",
+	StatusHalftime:           "Halftime",
+	StatusSecondHalf:         "Second Half, 2nd Half Started",
+	StatusExtraTime:          "Extra Time",
+	StatusBreakTime:          "Break Time",
+	StatusPenaltyInProgress:  "Penalty In Progress",
+	StatusSuspended:          "Match Suspended",
+	StatusInterrupted:        "Match Interrupted",
+	StatusFinished:           "Match Finished",
+	StatusFinishedAfterExtra: "Match Finished After Extra Time",
+	StatusFinishedAfterPens:  "Match Finished After Penalty",
+	StatusPostponed:          "Match Postponed",
+	StatusCancelled:          "Match Cancelled",
+	StatusAbandoned:          "Match Abandoned",
+	StatusTechnicalLoss:      "Technical Loss",
+	StatusWalkOver:           "WalkOver",
+	StatusLive:               "In Progress",
+}
+
+// Groups of statuses, usable as filters for the 'status' parameter.
+var (
+	// ScheduledStatuses are the statuses of fixtures that have not started.
+	ScheduledStatuses = StatusFilter{StatusTimeToBeDefined, StatusNotStarted}
+	// LiveStatuses are the statuses of fixtures in play, including breaks
+	// and suspensions.
+	LiveStatuses = StatusFilter{
+		StatusFirstHalf, StatusHalftime, StatusSecondHalf, StatusExtraTime, StatusBreakTime,
+		StatusPenaltyInProgress, StatusSuspended, StatusInterrupted, StatusLive,
+	}
+	// FinishedStatuses are the statuses of fixtures played to the end.
+	FinishedStatuses = StatusFilter{StatusFinished, StatusFinishedAfterExtra, StatusFinishedAfterPens}
+	// CancelledStatuses are the statuses of fixtures that will not be
+	// completed.
+	CancelledStatuses = StatusFilter{StatusCancelled, StatusAbandoned}
+)
+
+// IsKnown reports whether s is one of the documented statuses.
+func (s FixtureStatus) IsKnown() bool {
+	_, ok := statusLongNames[s]
+	return ok
+}
+
+// LongName returns the long name of s, e.g. "Match Finished" for FT, or the
+// empty string for an unknown status.
+func (s FixtureStatus) LongName() string {
+	return statusLongNames[s]
+}
+
+// IsScheduled reports whether the fixture has not started yet (TBD, NS).
+func (s FixtureStatus) IsScheduled() bool {
+	return s == StatusTimeToBeDefined || s == StatusNotStarted
+}
+
+// IsLive reports whether the fixture is in play (1H, HT, 2H, ET, BT, P,
+// SUSP, INT, LIVE). Suspended and interrupted fixtures count as live since
+// they may resume.
+func (s FixtureStatus) IsLive() bool {
+	return LiveStatuses.Contains(s)
+}
+
+// IsFinished reports whether the fixture was played to the end (FT, AET,
+// PEN).
+func (s FixtureStatus) IsFinished() bool {
+	return FinishedStatuses.Contains(s)
+}
+
+// IsCancelled reports whether the fixture will not be completed (CANC, ABD).
+// Postponed fixtures (PST) are not cancelled: they get a new date.
+func (s FixtureStatus) IsCancelled() bool {
+	return CancelledStatuses.Contains(s)
+}
+
+// HasResult reports whether the fixture has a final result: it finished, or
+// was awarded (AWD, WO).
+func (s FixtureStatus) HasResult() bool {
+	return s.IsFinished() || s == StatusTechnicalLoss || s == StatusWalkOver
+}
+
+// IsFinal reports whether the status can no longer change: the fixture has
+// a result or was cancelled.
+func (s FixtureStatus) IsFinal() bool {
+	return s.HasResult() || s.IsCancelled()
+}
+
+// StatusFilter is a set of statuses for the 'status' parameter of the
+// fixtures endpoints. It encodes as the API's "NS-PST-FT" form, so it can be
+// passed directly as a parameter value.
+type StatusFilter []FixtureStatus
+
+// String returns the statuses joined with "-".
+func (f StatusFilter) String() string {
+	codes := make([]string, len(f))
+	for i, s := range f {
+		codes[i] = string(s)
+	}
+	return strings.Join(codes, "-")
+}
+
+// Contains reports whether s is in f.
+func (f StatusFilter) Contains(s FixtureStatus) bool {
+	for _, status := range f {
+		if status == s {
+			return true
+		}
+	}
+	return false
+}
+
+// ParseStatusFilter parses a "NS-PST-FT" style status list. It returns an
+// error naming the first unknown status.
+func ParseStatusFilter(s string) (StatusFilter, error) {
+	var f StatusFilter
+	for _, code := range strings.Split(s, "-") {
+		status := FixtureStatus(code)
+		if !status.IsKnown() {
+			return nil, fmt.Errorf("unknown fixture status %q", code)
+		}
+		f = append(f, status)
+	}
+	return f, nil
+}
@@ -4,405 +4,178 @@ import "time"
 
 // FixturesStatisticsResponse is the response from the /fixtures/statistics endpoint
 type FixturesStatisticsResponse struct {
-	Get        string     `json:"get"`
-	Parameters any        `json:"parameters"`
-	Errors     any        `json:"errors"`
-	Results    int        `json:"results"`
-	Paging     Pagination `json:"paging"`
-	Response   []struct {
-		Team struct {
-			ID   int    `json:"id"`
-			Name string `json:"name"`
-			Logo string `json:"logo"`
-		} `json:"team"`
-		Statistics []struct {
-			Type  string     `json:"type"`
-			Value FlexString `json:"value"`
-		} `json:"statistics"`
-	} `json:"response"`
+	Envelope[[]FixtureTeamStatistics]
 }
 
 // FixturesResponse is the response from the /fixtures endpoint
 type FixturesResponse struct {
-	Get        string     `json:"get"`
-	Parameters any        `json:"parameters"`
-	Errors     any        `json:"errors"`
-	Results    int        `json:"results"`
-	Paging     Pagination `json:"paging"`
-	Response   []struct {
-		Fixture struct {
-			ID        int       `json:"id"`
-			Referee   string    `json:"referee"`
-			Timezone  string    `json:"timezone"`
-			Date      time.Time `json:"date"`
-			Timestamp int       `json:"timestamp"`
-			Periods   struct {
-				First  int `json:"first"`
-				Second int `json:"second"`
-			} `json:"periods"`
-			Venue struct {
-				ID   int    `json:"id"`
-				Name string `json:"name"`
-				City string `json:"city"`
-			} `json:"venue"`
-			Status struct {
-				Long    string `json:"long"`
-				Short   string `json:"short"`
-				Elapsed int    `json:"elapsed"`
-			} `json:"status"`
-		} `json:"fixture"`
-		League struct {
-			ID      int    `json:"id"`
-			Name    string `json:"name"`
-			Country string `json:"country"`
-			Logo    string `json:"logo"`
-			Flag    string `json:"flag"`
-			Season  int    `json:"season"`
-			Round   string `json:"round"`
-		} `json:"league"`
-		Teams struct {
-			Home struct {
-				ID     int    `json:"id"`
-				Name   string `json:"name"`
-				Logo   string `json:"logo"`
-				Winner bool   `json:"winner"`
-			} `json:"home"`
-			Away struct {
-				ID     int    `json:"id"`
-				Name   string `json:"name"`
-				Logo   string `json:"logo"`
-				Winner bool   `json:"winner"`
-			} `json:"away"`
-		} `json:"teams"`
-		Goals struct {
-			Home int `json:"home"`
-			Away int `json:"away"`
-		} `json:"goals"`
-		Score struct {
-			Halftime struct {
-				Home int `json:"home"`
-				Away int `json:"away"`
-			} `json:"halftime"`
-			Fulltime struct {
-				Home int `json:"home"`
-				Away int `json:"away"`
-			} `json:"fulltime"`
-			Extratime struct {
-				Home *int `json:"home"`
-				Away *int `json:"away"`
-			} `json:"extratime"`
-			Penalty struct {
-				Home *int `json:"home"`
-				Away *int `json:"away"`
-			} `json:"penalty"`
-		} `json:"score"`
-		Lineups []struct {
-			Team struct {
-				ID     int    `json:"id"`
-				Name   string `json:"name"`
-				Logo   string `json:"logo"`
-				Colors struct {
-					Player struct {
-						Primary string `json:"primary"`
-						Number  string `json:"number"`
-						Border  string `json:"border"`
-					} `json:"player"`
-					Goalkeeper struct {
-						Primary string `json:"primary"`
-						Number  string `json:"number"`
-						Border  string `json:"border"`
-					} `json:"goalkeeper"`
-				} `json:"colors"`
-			} `json:"team"`
-			Formation string `json:"formation"`
-			StartXI   []struct {
-				Player struct {
-					ID     int    `json:"id"`
-					Name   string `json:"name"`
-					Number int    `json:"number"`
-					Pos    string `json:"pos"`
-					Grid   string `json:"grid"`
-				} `json:"player"`
-			} `json:"startXI"`
-			Substitutes []struct {
-				Player struct {
-					ID     int    `json:"id"`
-					Name   string `json:"name"`
-					Number int    `json:"number"`
-					Pos    string `json:"pos"`
-					Grid   string `json:"grid"`
-				} `json:"player"`
-			} `json:"substitutes"`
-			Coach struct {
-				ID    int    `json:"id"`
-				Name  string `json:"name"`
-				Photo string `json:"photo"`
-			} `json:"coach"`
-		} `json:"lineups"`
-		Statistics []struct {
-			Team struct {
-				ID   int    `json:"id"`
-				Name string `json:"name"`
-				Logo string `json:"logo"`
-			} `json:"team"`
-			Statistics []struct {
-				Type  string     `json:"type"`
-				Value FlexString `json:"value"`
-			} `json:"statistics"`
-		} `json:"statistics"`
-		Players []struct {
-			Team struct {
-				ID     int       `json:"id"`
-				Name   string    `json:"name"`
-				Logo   string    `json:"logo"`
-				Update time.Time `json:"update"`
-			} `json:"team"`
-			Players []struct {
-				Player struct {
-					ID    int    `json:"id"`
-					Name  string `json:"name"`
-					Photo string `json:"photo"`
-				} `json:"player"`
-				Statistics []struct {
-					Games struct {
-						Minutes    int    `json:"minutes"`
-						Number     int    `json:"number"`
-						Position   string `json:"position"`
-						Rating     string `json:"rating"`
-						Captain    bool   `json:"captain"`
-						Substitute bool   `json:"substitute"`
-					} `json:"games"`
-					Offsides int `json:"offsides"`
-					Shots    struct {
-						Total int `json:"total"`
-						On    int `json:"on"`
-					} `json:"shots"`
-					Goals struct {
-						Total    int `json:"total"`
-						Conceded int `json:"conceded"`
-						Assists  int `json:"assists"`
-						Saves    int `json:"saves"`
-					} `json:"goals"`
-					Passes struct {
-						Total    int    `json:"total"`
-						Key      int    `json:"key"`
-						Accuracy string `json:"accuracy"`
-					} `json:"passes"`
-					Tackles struct {
-						Total         int `json:"total"`
-						Blocks        int `json:"blocks"`
-						Interceptions int `json:"interceptions"`
-					} `json:"tackles"`
-					Duels struct {
-						Total int `json:"total"`
-						Won   int `json:"won"`
-					} `json:"duels"`
-					Dribbles struct {
-						Attempts int `json:"attempts"`
-						Success  int `json:"success"`
-						Past     int `json:"past"`
-					} `json:"dribbles"`
-					Fouls struct {
-						Drawn     int `json:"drawn"`
-						Committed int `json:"committed"`
-					} `json:"fouls"`
-					Cards struct {
-						Yellow int `json:"yellow"`
-						Red    int `json:"red"`
-					} `json:"cards"`
-					Penalty struct {
-						Won       int `json:"won"`
-						Committed int `json:"commited"`
-						Scored    int `json:"scored"`
-						Missed    int `json:"missed"`
-						Saved     int `json:"saved"`
-					} `json:"penalty"`
-				} `json:"statistics"`
-			} `json:"players"`
-		} `json:"players"`
-		Events []struct {
-			Time struct {
-				Elapsed int        `json:"elapsed"`
-				Extra   FlexString `json:"extra"`
-			} `json:"time"`
-			Team struct {
-				ID   int    `json:"id"`
-				Name string `json:"name"`
-				Logo string `json:"logo"`
-			} `json:"team"`
-			Player struct {
-				ID   int    `json:"id"`
-				Name string `json:"name"`
-			} `json:"player"`
-			Assist struct {
-				ID   int    `json:"id"`
-				Name string `json:"name"`
-			} `json:"assist"`
-			Type     string `json:"type"`
-			Detail   string `json:"detail"`
-			Comments string `json:"comments"`
-		} `json:"events"`
-	} `json:"response"`
+	Envelope[[]FixtureDetails]
+}
+
+// FixtureDetails is a fixture as returned by the /fixtures endpoint. When
+// fetched by id, it also carries the lineups, statistics, player statistics
+// and events of the fixture.
+type FixtureDetails struct {
+	Fixture    FixtureInfo             `json:"fixture"`
+	League     FixtureLeague           `json:"league"`
+	Teams      FixtureTeams            `json:"teams"`
+	Goals      Goals                   `json:"goals"`
+	Score      Score                   `json:"score"`
+	Lineups    []Lineup                `json:"lineups"`
+	Statistics []FixtureTeamStatistics `json:"statistics"`
+	Players    []FixtureTeamPlayers    `json:"players"`
+	Events     []Event                 `json:"events"`
+}
+
+// Summary returns the fixture without its details.
+func (f FixtureDetails) Summary() Fixture {
+	return Fixture{
+		Fixture: f.Fixture,
+		League:  f.League,
+		Teams:   f.Teams,
+		Goals:   f.Goals,
+		Score:   f.Score,
+	}
+}
+
+// FixtureTeamStatistics holds the statistics of one team in a fixture.
+type FixtureTeamStatistics struct {
+	Team       TeamRef     `json:"team"`
+	Statistics []Statistic `json:"statistics"`
+}
+
+// Statistic is a single statistic of a team in a fixture, such as "Shots on
+// Goal" or "Ball Possession".
+type Statistic struct {
+	Type  string     `json:"type"`
+	Value FlexString `json:"value"`
+}
+
+// Lineup is the lineup of one team in a fixture.
+type Lineup struct {
+	Team        LineupTeam    `json:"team"`
+	Coach       CoachRef      `json:"coach"`
+	Formation   string        `json:"formation"`
+	StartXI     []LineupEntry `json:"startXI"`
+	Substitutes []LineupEntry `json:"substitutes"`
+}
+
+// LineupTeam is a team in a lineup, with its kit colors.
+type LineupTeam struct {
+	TeamRef
+	Colors struct {
+		Player     KitColors `json:"player"`
+		Goalkeeper KitColors `json:"goalkeeper"`
+	} `json:"colors"`
+}
+
+// KitColors are the hex colors of a kit.
+type KitColors struct {
+	Primary string `json:"primary"`
+	Number  string `json:"number"`
+	Border  string `json:"border"`
+}
+
+// LineupEntry is a player in a starting eleven or on the bench.
+type LineupEntry struct {
+	Player LineupPlayer `json:"player"`
+}
+
+// LineupPlayer is a player in a lineup. Grid is the "row:column" position
+// on the pitch, empty for substitutes.
+type LineupPlayer struct {
+	ID     int    `json:"id"`
+	Name   string `json:"name"`
+	Number int    `json:"number"`
+	Pos    string `json:"pos"`
+	Grid   string `json:"grid"`
+}
+
+// Event is an event of a fixture: a goal, card, substitution or VAR decision.
+type Event struct {
+	Time struct {
+		Elapsed int        `json:"elapsed"`
+		Extra   FlexString `json:"extra"`
+	} `json:"time"`
+	Team     TeamRef   `json:"team"`
+	Player   PlayerRef `json:"player"`
+	Assist   PlayerRef `json:"assist"`
+	Type     string    `json:"type"`
+	Detail   string    `json:"detail"`
+	Comments string    `json:"comments"`
+}
+
+// FixtureTeamPlayers holds the statistics of the players of one team in a
+// fixture.
+type FixtureTeamPlayers struct {
+	Team struct {
+		TeamRef
+		Update time.Time `json:"update"`
+	} `json:"team"`
+	Players []PlayerMatchStats `json:"players"`
+}
+
+// PlayerMatchStats is a player and their statistics in one fixture.
+type PlayerMatchStats struct {
+	Player struct {
+		ID    int    `json:"id"`
+		Name  string `json:"name"`
+		Photo string `json:"photo"`
+	} `json:"player"`
+	Statistics []MatchStatistics `json:"statistics"`
+}
+
+// MatchStatistics are the statistics of a player in one fixture.
+type MatchStatistics struct {
+	Games struct {
+		Minutes    int    `json:"minutes"`
+		Number     int    `json:"number"`
+		Position   string `json:"position"`
+		Rating     string `json:"rating"`
+		Captain    bool   `json:"captain"`
+		Substitute bool   `json:"substitute"`
+	} `json:"games"`
+	Offsides int         `json:"offsides"`
+	Shots    ShotStats   `json:"shots"`
+	Goals    PlayerGoals `json:"goals"`
+	Passes   struct {
+		Total    int    `json:"total"`
+		Key      int    `json:"key"`
+		Accuracy string `json:"accuracy"`
+	} `json:"passes"`
+	Tackles  TackleStats  `json:"tackles"`
+	Duels    DuelStats    `json:"duels"`
+	Dribbles DribbleStats `json:"dribbles"`
+	Fouls    FoulStats    `json:"fouls"`
+	Cards    struct {
+		Yellow int `json:"yellow"`
+		Red    int `json:"red"`
+	} `json:"cards"`
+	Penalty PenaltyStats `json:"penalty"`
+}
+
+// Rating returns Games.Rating as a number. ok is false when the player has
+// no rating, as for unused substitutes.
+func (s MatchStatistics) Rating() (float64, bool) {
+	return FlexString(s.Games.Rating).Float()
+}
+
+// PassAccuracy returns Passes.Accuracy as a number.
+func (s MatchStatistics) PassAccuracy() (float64, bool) {
+	return FlexString(s.Passes.Accuracy).Percent()
 }
 
 // FixturesEventsResponse is the response from the /fixtures/events endpoint
 type FixturesEventsResponse struct {
-	Get        string     `json:"get"`
-	Parameters any        `json:"parameters"`
-	Errors     any        `json:"errors"`
-	Results    int        `json:"results"`
-	Paging     Pagination `json:"paging"`
-	Response   []struct {
-		Time struct {
-			Elapsed int        `json:"elapsed"`
-			Extra   FlexString `json:"extra"`
-		} `json:"time"`
-		Team struct {
-			ID   int    `json:"id"`
-			Name string `json:"name"`
-			Logo string `json:"logo"`
-		} `json:"team"`
-		Player struct {
-			ID   int    `json:"id"`
-			Name string `json:"name"`
-		} `json:"player"`
-		Assist struct {
-			ID   int    `json:"id"`
-			Name string `json:"name"`
-		} `json:"assist"`
-		Type     string `json:"type"`
-		Detail   string `json:"detail"`
-		Comments string `json:"comments"`
-	} `json:"response"`
+	Envelope[[]Event]
 }
 
 // FixturesLineupsResponse is the response from the /fixtures/lineups endpoint
 type FixturesLineupsResponse struct {
-	Get        string     `json:"get"`
-	Parameters any        `json:"parameters"`
-	Errors     any        `json:"errors"`
-	Results    int        `json:"results"`
-	Paging     Pagination `json:"paging"`
-	Response   []struct {
-		Team struct {
-			ID     int    `json:"id"`
-			Name   string `json:"name"`
-			Logo   string `json:"logo"`
-			Colors struct {
-				Player struct {
-					Primary string `json:"primary"`
-					Number  string `json:"number"`
-					Border  string `json:"border"`
-				} `json:"player"`
-				Goalkeeper struct {
-					Primary string `json:"primary"`
-					Number  string `json:"number"`
-					Border  string `json:"border"`
-				} `json:"goalkeeper"`
-			} `json:"colors"`
-		} `json:"team"`
-		Coach struct {
-			ID    int    `json:"id"`
-			Name  string `json:"name"`
-			Photo string `json:"photo"`
-		} `json:"coach"`
-		Formation string `json:"formation"`
-		StartXI   []struct {
-			Player struct {
-				ID     int    `json:"id"`
-				Name   string `json:"name"`
-				Number int    `json:"number"`
-				Pos    string `json:"pos"`
-				Grid   string `json:"grid"`
-			} `json:"player"`
-		} `json:"startXI"`
-		Substitutes []struct {
-			Player struct {
-				ID     int    `json:"id"`
-				Name   string `json:"name"`
-				Number int    `json:"number"`
-				Pos    string `json:"pos"`
-				Grid   string `json:"grid"`
-			} `json:"player"`
-		} `json:"substitutes"`
-	} `json:"response"`
+	Envelope[[]Lineup]
 }
 
 // FixturesPlayersResponse is the response from the /fixtures/players endpoint
 type FixturesPlayersResponse struct {
-	Get        string     `json:"get"`
-	Parameters any        `json:"parameters"`
-	Errors     any        `json:"errors"`
-	Results    int        `json:"results"`
-	Paging     Pagination `json:"paging"`
-	Response   []struct {
-		Team struct {
-			ID     int       `json:"id"`
-			Name   string    `json:"name"`
-			Logo   string    `json:"logo"`
-			Update time.Time `json:"update"`
-		} `json:"team"`
-		Players []struct {
-			Player struct {
-				ID    int    `json:"id"`
-				Name  string `json:"name"`
-				Photo string `json:"photo"`
-			} `json:"player"`
-			Statistics []struct {
-				Games struct {
-					Minutes    int    `json:"minutes"`
-					Number     int    `json:"number"`
-					Position   string `json:"position"`
-					Rating     string `json:"rating"`
-					Captain    bool   `json:"captain"`
-					Substitute bool   `json:"substitute"`
-				} `json:"games"`
-				Offsides int `json:"offsides"`
-				Shots    struct {
-					Total int `json:"total"`
-					On    int `json:"on"`
-				} `json:"shots"`
-				Goals struct {
-					Total    int `json:"total"`
-					Conceded int `json:"conceded"`
-					Assists  int `json:"assists"`
-					Saves    int `json:"saves"`
-				} `json:"goals"`
-				Passes struct {
-					Total    int    `json:"total"`
-					Key      int    `json:"key"`
-					Accuracy string `json:"accuracy"`
-				} `json:"passes"`
-				Tackles struct {
-					Total         int `json:"total"`
-					Blocks        int `json:"blocks"`
-					Interceptions int `json:"interceptions"`
-				} `json:"tackles"`
-				Duels struct {
-					Total int `json:"total"`
-					Won   int `json:"won"`
-				} `json:"duels"`
-				Dribbles struct {
-					Attempts int `json:"attempts"`
-					Success  int `json:"success"`
-					Past     int `json:"past"`
-				} `json:"dribbles"`
-				Fouls struct {
-					Drawn     int `json:"drawn"`
-					Committed int `json:"committed"`
-				} `json:"fouls"`
-				Cards struct {
-					Yellow int `json:"yellow"`
-					Red    int `json:"red"`
-				} `json:"cards"`
-				Penalty struct {
-					Won       int `json:"won"`
-					Committed int `json:"commited"`
-					Scored    int `json:"scored"`
-					Missed    int `json:"missed"`
-					Saved     int `json:"saved"`
-				} `json:"penalty"`
-			} `json:"statistics"`
-		} `json:"players"`
-	} `json:"response"`
+	Envelope[[]FixtureTeamPlayers]
 }
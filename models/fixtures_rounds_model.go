@@ -4,12 +4,7 @@ import "encoding/json"
 
 // FixturesRoundsResponse is the response from the /fixtures/rounds endpoint.
 type FixturesRoundsResponse struct {
-	Get        string     `json:"get"`
-	Parameters any        `json:"parameters"`
-	Errors     any        `json:"errors"`
-	Results    int        `json:"results"`
-	Paging     Pagination `json:"paging"`
-	Response   []Round    `json:"response"`
+	Envelope[[]Round]
 }
 
 // Round is a single round entry from the /fixtures/rounds endpoint. The API
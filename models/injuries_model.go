@@ -1,40 +1,20 @@
 package models
 
-import "time"
-
 // InjuriesResponse is the response from the /injuries endpoints
 type InjuriesResponse struct {
-	Get        string     `json:"get"`
-	Parameters any        `json:"parameters"`
-	Errors     any        `json:"errors"`
-	Results    int        `json:"results"`
-	Paging     Pagination `json:"paging"`
-	Response   []struct {
-		Player struct {
-			ID     int    `json:"id"`
-			Name   string `json:"name"`
-			Photo  string `json:"photo"`
-			Type   string `json:"type"`
-			Reason string `json:"reason"`
-		} `json:"player"`
-		Team struct {
-			ID   int    `json:"id"`
-			Name string `json:"name"`
-			Logo string `json:"logo"`
-		} `json:"team"`
-		Fixture struct {
-			ID        int       `json:"id"`
-			Timezone  string    `json:"timezone"`
-			Date      time.Time `json:"date"`
-			Timestamp int       `json:"timestamp"`
-		} `json:"fixture"`
-		League struct {
-			ID      int    `json:"id"`
-			Season  int    `json:"season"`
-			Name    string `json:"name"`
-			Country string `json:"country"`
-			Logo    string `json:"logo"`
-			Flag    string `json:"flag"`
-		} `json:"league"`
-	} `json:"response"`
+	Envelope[[]Injury]
+}
+
+// Injury is a player missing a fixture through injury or suspension.
+type Injury struct {
+	Player struct {
+		ID     int    `json:"id"`
+		Name   string `json:"name"`
+		Photo  string `json:"photo"`
+		Type   string `json:"type"`
+		Reason string `json:"reason"`
+	} `json:"player"`
+	Team    TeamRef    `json:"team"`
+	Fixture FixtureRef `json:"fixture"`
+	League  LeagueRef  `json:"league"`
 }
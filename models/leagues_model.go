@@ -2,44 +2,44 @@ package models
 
 // LeagueResponse is the response from the /leagues endpoint
 type LeaguesResponse struct {
-	Get        string     `json:"get"`
-	Parameters any        `json:"parameters"`
-	Errors     any        `json:"errors"`
-	Results    int        `json:"results"`
-	Paging     Pagination `json:"paging"`
-	Response   []struct {
-		League struct {
-			ID   int    `json:"id"`
-			Name string `json:"name"`
-			Type string `json:"type"`
-			Logo string `json:"logo"`
-		} `json:"league"`
-		Country struct {
-			Name string `json:"name"`
-			Code string `json:"code"`
-			Flag string `json:"flag"`
-		} `json:"country"`
-		Seasons []struct {
-			Year     int    `json:"year"`
-			Start    string `json:"start"`
-			End      string `json:"end"`
-			Current  bool   `json:"current"`
-			Coverage struct {
-				Fixtures struct {
-					Events             bool `json:"events"`
-					Lineups            bool `json:"lineups"`
-					StatisticsFixtures bool `json:"statistics_fixtures"`
-					StatisticsPlayers  bool `json:"statistics_players"`
-				} `json:"fixtures"`
-				Standings   bool `json:"standings"`
-				Players     bool `json:"players"`
-				TopScorers  bool `json:"top_scorers"`
-				TopAssists  bool `json:"top_assists"`
-				TopCards    bool `json:"top_cards"`
-				Injuries    bool `json:"injuries"`
-				Predictions bool `json:"predictions"`
-				Odds        bool `json:"odds"`
-			} `json:"coverage"`
-		} `json:"seasons"`
-	} `json:"response"`
+	Envelope[[]LeagueInfo]
+}
+
+// LeagueInfo is a league or cup, its country, and the seasons available.
+type LeagueInfo struct {
+	League  League         `json:"league"`
+	Country Country        `json:"country"`
+	Seasons []LeagueSeason `json:"seasons"`
+}
+
+// League is the profile of a league or cup. Type is "League" or "Cup".
+type League struct {
+	ID   int    `json:"id"`
+	Name string `json:"name"`
+	Type string `json:"type"`
+	Logo string `json:"logo"`
+}
+
+// LeagueSeason is a season of a league and the data the API covers for it.
+type LeagueSeason struct {
+	Year     int    `json:"year"`
+	Start    string `json:"start"`
+	End      string `json:"end"`
+	Current  bool   `json:"current"`
+	Coverage struct {
+		Fixtures struct {
+			Events             bool `json:"events"`
+			Lineups            bool `json:"lineups"`
+			StatisticsFixtures bool `json:"statistics_fixtures"`
+			StatisticsPlayers  bool `json:"statistics_players"`
+		} `json:"fixtures"`
+		Standings   bool `json:"standings"`
+		Players     bool `json:"players"`
+		TopScorers  bool `json:"top_scorers"`
+		TopAssists  bool `json:"top_assists"`
+		TopCards    bool `json:"top_cards"`
+		Injuries    bool `json:"injuries"`
+		Predictions bool `json:"predictions"`
+		Odds        bool `json:"odds"`
+	} `json:"coverage"`
 }
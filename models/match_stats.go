@@ -0,0 +1,94 @@
+package models
+
+import "strings"
+
+// TeamMatchStats is the typed form of a team's statistics array in a
+// fixture. Statistics the API did not report, or reported as null, are zero.
+// Possession and PassesPercent are percentages (55 for "55%").
+type TeamMatchStats struct {
+	ShotsOnGoal     int
+	ShotsOffGoal    int
+	TotalShots      int
+	BlockedShots    int
+	ShotsInsideBox  int
+	ShotsOutBox     int
+	Fouls           int
+	CornerKicks     int
+	Offsides        int
+	Possession      float64
+	YellowCards     int
+	RedCards        int
+	GoalkeeperSaves int
+	TotalPasses     int
+	PassesAccurate  int
+	PassesPercent   float64
+	ExpectedGoals   float64
+	GoalsPrevented  float64
+
+	// Unknown holds the statistics whose type is not one of the above, or
+	// whose value could not be parsed, in the order the API sent them.
+	Unknown []Statistic
+}
+
+// matchStatInts and matchStatFloats map the statistic types API-Football
+// reports, lowercased, to the TeamMatchStats field they fill.
+var (
+	matchStatInts = map[string]func(*TeamMatchStats) *int{
+		"shots on goal":    func(s *TeamMatchStats) *int { return &s.ShotsOnGoal },
+		"shots off goal":   func(s *TeamMatchStats) *int { return &s.ShotsOffGoal },
+		"total shots":      func(s *TeamMatchStats) *int { return &s.TotalShots },
+		"blocked shots":    func(s *TeamMatchStats) *int { return &s.BlockedShots },
+		"shots insidebox":  func(s *TeamMatchStats) *int { return &s.ShotsInsideBox },
+		"shots outsidebox": func(s *TeamMatchStats) *int { return &s.ShotsOutBox },
+		"fouls":            func(s *TeamMatchStats) *int { return &s.Fouls },
+		"corner kicks":     func(s *TeamMatchStats) *int { return &s.CornerKicks },
+		"offsides":         func(s *TeamMatchStats) *int { return &s.Offsides },
+		"yellow cards":     func(s *TeamMatchStats) *int { return &s.YellowCards },
+		"red cards":        func(s *TeamMatchStats) *int { return &s.RedCards },
+		"goalkeeper saves": func(s *TeamMatchStats) *int { return &s.GoalkeeperSaves },
+		"total passes":     func(s *TeamMatchStats) *int { return &s.TotalPasses },
+		"passes accurate":  func(s *TeamMatchStats) *int { return &s.PassesAccurate },
+	}
+	matchStatFloats = map[string]func(*TeamMatchStats) *float64{
+		"ball possession": func(s *TeamMatchStats) *float64 { return &s.Possession },
+		"passes %":        func(s *TeamMatchStats) *float64 { return &s.PassesPercent },
+		"expected_goals":  func(s *TeamMatchStats) *float64 { return &s.ExpectedGoals },
+		"goals_prevented": func(s *TeamMatchStats) *float64 { return &s.GoalsPrevented },
+	}
+)
+
+// NewTeamMatchStats builds a TeamMatchStats from a statistics array. The
+// API's statistics are not always clean: a value that is not a number (or a
+// percentage, for the percentage statistics) leaves its field zero and is
+// collected in Unknown, along with unrecognized types, so the other
+// statistics are still filled.
+func NewTeamMatchStats(stats []Statistic) TeamMatchStats {
+	var out TeamMatchStats
+	for _, stat := range stats {
+		key := strings.ToLower(strings.TrimSpace(stat.Type))
+		intField, isInt := matchStatInts[key]
+		floatField, isFloat := matchStatFloats[key]
+		switch {
+		case (isInt || isFloat) && stat.Value.IsNull():
+			continue
+		case isInt:
+			if n, ok := stat.Value.Int(); ok {
+				*intField(&out) = n
+				continue
+			}
+		case isFloat:
+			if f, ok := stat.Value.Percent(); ok {
+				*floatField(&out) = f
+				continue
+			}
+		}
+		out.Unknown = append(out.Unknown, stat)
+	}
+	return out
+}
+
+// MatchStats returns the team's statistics in typed form. See
+// NewTeamMatchStats.
+func (s FixtureTeamStatistics) MatchStats() TeamMatchStats {
+	return NewTeamMatchStats(s.Statistics)
+}
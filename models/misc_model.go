@@ -2,12 +2,7 @@ package models
 
 // TimezoneResponse is the response from the /timezone endpoint.
 type TimezoneResponse struct {
-	Get        string     `json:"get"`
-	Parameters any        `json:"parameters"`
-	Errors     any        `json:"errors"`
-	Results    int        `json:"results"`
-	Paging     Pagination `json:"paging"`
-	Response   []string   `json:"response"`
+	Envelope[[]string]
 }
 
 // Country is a single country entry as returned by the /countries and
@@ -21,21 +16,11 @@ type Country struct {
 // CountriesResponse is the response from the /countries and /teams/countries
 // endpoints.
 type CountriesResponse struct {
-	Get        string     `json:"get"`
-	Parameters any        `json:"parameters"`
-	Errors     any        `json:"errors"`
-	Results    int        `json:"results"`
-	Paging     Pagination `json:"paging"`
-	Response   []Country  `json:"response"`
+	Envelope[[]Country]
 }
 
 // SeasonsResponse is the response from the /leagues/seasons and /teams/seasons
 // endpoints. Seasons are 4-digit years.
 type SeasonsResponse struct {
-	Get        string     `json:"get"`
-	Parameters any        `json:"parameters"`
-	Errors     any        `json:"errors"`
-	Results    int        `json:"results"`
-	Paging     Pagination `json:"paging"`
-	Response   []int      `json:"response"`
+	Envelope[[]int]
 }
@@ -10,6 +10,12 @@ type BetValue struct {
 	Odd   string `json:"odd"`
 }
 
+// Price returns Odd as an exact Decimal. ok is false if the odd is missing
+// or malformed.
+func (v BetValue) Price() (Decimal, bool) {
+	return FlexString(v.Odd).Decimal()
+}
+
 // IDName is a simple id/name pair used by the bookmakers and bets endpoints.
 type IDName struct {
 	ID   int    `json:"id"`
@@ -18,127 +24,124 @@ type IDName struct {
 
 // OddsResponse is the response from the /odds (pre-match) endpoint.
 type OddsResponse struct {
-	Get        string     `json:"get"`
-	Parameters any        `json:"parameters"`
-	Errors     any        `json:"errors"`
-	Results    int        `json:"results"`
-	Paging     Pagination `json:"paging"`
-	Response   []struct {
-		League struct {
-			ID      int    `json:"id"`
-			Name    string `json:"name"`
-			Country string `json:"country"`
-			Logo    string `json:"logo"`
-			Flag    string `json:"flag"`
-			Season  int    `json:"season"`
-		} `json:"league"`
-		Fixture struct {
-			ID        int       `json:"id"`
-			Timezone  string    `json:"timezone"`
-			Date      time.Time `json:"date"`
-			Timestamp int64     `json:"timestamp"`
-		} `json:"fixture"`
-		Update     time.Time `json:"update"`
-		Bookmakers []struct {
-			ID   int    `json:"id"`
-			Name string `json:"name"`
-			Bets []struct {
-				ID     int        `json:"id"`
-				Name   string     `json:"name"`
-				Values []BetValue `json:"values"`
-			} `json:"bets"`
-		} `json:"bookmakers"`
-	} `json:"response"`
+	Envelope[[]FixtureOdds]
 }
 
 // OddsMappingResponse is the response from the /odds/mapping endpoint.
 type OddsMappingResponse struct {
-	Get        string     `json:"get"`
-	Parameters any        `json:"parameters"`
-	Errors     any        `json:"errors"`
-	Results    int        `json:"results"`
-	Paging     Pagination `json:"paging"`
-	Response   []struct {
-		League struct {
-			ID     int `json:"id"`
-			Season int `json:"season"`
-		} `json:"league"`
-		Fixture struct {
-			ID        int       `json:"id"`
-			Date      time.Time `json:"date"`
-			Timestamp int64     `json:"timestamp"`
-		} `json:"fixture"`
-		Update time.Time `json:"update"`
-	} `json:"response"`
+	Envelope[[]OddsMapping]
 }
 
 // OddsBookmakersResponse is the response from the /odds/bookmakers endpoint.
 type OddsBookmakersResponse struct {
-	Get        string     `json:"get"`
-	Parameters any        `json:"parameters"`
-	Errors     any        `json:"errors"`
-	Results    int        `json:"results"`
-	Paging     Pagination `json:"paging"`
-	Response   []IDName   `json:"response"`
+	Envelope[[]IDName]
 }
 
 // OddsBetsResponse is the response from the /odds/bets and /odds/live/bets
 // endpoints.
 type OddsBetsResponse struct {
-	Get        string     `json:"get"`
-	Parameters any        `json:"parameters"`
-	Errors     any        `json:"errors"`
-	Results    int        `json:"results"`
-	Paging     Pagination `json:"paging"`
-	Response   []IDName   `json:"response"`
+	Envelope[[]IDName]
 }
 
 // OddsLiveResponse is the response from the /odds/live (in-play) endpoint.
 type OddsLiveResponse struct {
-	Get        string     `json:"get"`
-	Parameters any        `json:"parameters"`
-	Errors     any        `json:"errors"`
-	Results    int        `json:"results"`
-	Paging     Pagination `json:"paging"`
-	Response   []struct {
-		Fixture struct {
-			ID     int `json:"id"`
-			Status struct {
-				Long    string `json:"long"`
-				Elapsed int    `json:"elapsed"`
-				Seconds string `json:"seconds"`
-			} `json:"status"`
-		} `json:"fixture"`
-		League struct {
-			ID     int `json:"id"`
-			Season int `json:"season"`
-		} `json:"league"`
-		Teams struct {
-			Home struct {
-				ID    int `json:"id"`
-				Goals int `json:"goals"`
-			} `json:"home"`
-			Away struct {
-				ID    int `json:"id"`
-				Goals int `json:"goals"`
-			} `json:"away"`
-		} `json:"teams"`
+	Envelope[[]LiveOdds]
+}
+
+// LiveOdds is the in-play state and odds of one fixture.
+type LiveOdds struct {
+	Fixture struct {
+		ID     int `json:"id"`
 		Status struct {
-			Stopped  bool `json:"stopped"`
-			Blocked  bool `json:"blocked"`
-			Finished bool `json:"finished"`
+			Long    string `json:"long"`
+			Elapsed int    `json:"elapsed"`
+			Seconds string `json:"seconds"`
 		} `json:"status"`
-		Update time.Time `json:"update"`
-		Odds   []struct {
-			ID     int    `json:"id"`
-			Name   string `json:"name"`
-			Values []struct {
-				Value     any    `json:"value"`
-				Odd       string `json:"odd"`
-				Handicap  string `json:"handicap"`
-				Main      any    `json:"main"`
-				Suspended bool   `json:"suspended"`
-			} `json:"values"`
-		} `json:"odds"`
-	} `json:"response"`
+	} `json:"fixture"`
+	League struct {
+		ID     int `json:"id"`
+		Season int `json:"season"`
+	} `json:"league"`
+	Teams struct {
+		Home struct {
+			ID    int `json:"id"`
+			Goals int `json:"goals"`
+		} `json:"home"`
+		Away struct {
+			ID    int `json:"id"`
+			Goals int `json:"goals"`
+		} `json:"away"`
+	} `json:"teams"`
+	Status struct {
+		Stopped  bool `json:"stopped"`
+		Blocked  bool `json:"blocked"`
+		Finished bool `json:"finished"`
+	} `json:"status"`
+	Update time.Time `json:"update"`
+	Odds   []LiveBet `json:"odds"`
+}
+
+// LiveBet is an in-play market and its selections.
+type LiveBet struct {
+	ID     int            `json:"id"`
+	Name   string         `json:"name"`
+	Values []LiveBetValue `json:"values"`
+}
+
+// LiveBetValue is a selection of an in-play market. Handicap is the line
+// for handicap and over/under markets, and Main marks the main line among
+// them; the API sends null for Main on other markets.
+type LiveBetValue struct {
+	Value     any    `json:"value"`
+	Odd       string `json:"odd"`
+	Handicap  string `json:"handicap"`
+	Main      any    `json:"main"`
+	Suspended bool   `json:"suspended"`
+}
+
+// Price returns Odd as an exact Decimal. ok is false if the odd is missing
+// or malformed, as it is for some suspended selections.
+func (v LiveBetValue) Price() (Decimal, bool) {
+	return FlexString(v.Odd).Decimal()
+}
+
+// FixtureOdds holds the pre-match odds of every bookmaker for one fixture.
+type FixtureOdds struct {
+	League  LeagueRef `json:"league"`
+	Fixture struct {
+		ID        int       `json:"id"`
+		Timezone  string    `json:"timezone"`
+		Date      time.Time `json:"date"`
+		Timestamp int64     `json:"timestamp"`
+	} `json:"fixture"`
+	Update     time.Time   `json:"update"`
+	Bookmakers []Bookmaker `json:"bookmakers"`
+}
+
+// Bookmaker is a bookmaker and the bets it offers on a fixture.
+type Bookmaker struct {
+	ID   int    `json:"id"`
+	Name string `json:"name"`
+	Bets []Bet  `json:"bets"`
+}
+
+// Bet is a market, such as "Match Winner", and its selections.
+type Bet struct {
+	ID     int        `json:"id"`
+	Name   string     `json:"name"`
+	Values []BetValue `json:"values"`
+}
+
+// OddsMapping is a fixture for which pre-match odds are available.
+type OddsMapping struct {
+	League struct {
+		ID     int `json:"id"`
+		Season int `json:"season"`
+	} `json:"league"`
+	Fixture struct {
+		ID        int       `json:"id"`
+		Date      time.Time `json:"date"`
+		Timestamp int64     `json:"timestamp"`
+	} `json:"fixture"`
+	Update time.Time `json:"update"`
 }
@@ -2,242 +2,157 @@ package models
 
 // PlayersSeasonsResponse is the response from the /players/seasons endpoint
 type PlayersSeasonsResponse struct {
-	Get        string     `json:"get"`
-	Parameters any        `json:"parameters"`
-	Errors     any        `json:"errors"`
-	Results    int        `json:"results"`
-	Paging     Pagination `json:"paging"`
-	Response   []int      `json:"response"`
+	Envelope[[]int]
 }
 
 // PlayersResponse is the response from the /players endpoint
 type PlayersResponse struct {
-	Get        string     `json:"get"`
-	Parameters any        `json:"parameters"`
-	Errors     any        `json:"errors"`
-	Results    int        `json:"results"`
-	Paging     Pagination `json:"paging"`
-	Response   []struct {
-		Player struct {
-			ID        int    `json:"id"`
-			Name      string `json:"name"`
-			Firstname string `json:"firstname"`
-			Lastname  string `json:"lastname"`
-			Age       int    `json:"age"`
-			Birth     struct {
-				Date    string `json:"date"`
-				Place   string `json:"place"`
-				Country string `json:"country"`
-			} `json:"birth"`
-			Nationality string `json:"nationality"`
-			Height      string `json:"height"`
-			Weight      string `json:"weight"`
-			Injured     bool   `json:"injured"`
-			Photo       string `json:"photo"`
-		} `json:"player"`
-		Statistics []struct {
-			Team struct {
-				ID   int    `json:"id"`
-				Name string `json:"name"`
-				Logo string `json:"logo"`
-			} `json:"team"`
-			League struct {
-				ID      int    `json:"id"`
-				Name    string `json:"name"`
-				Country string `json:"country"`
-				Logo    string `json:"logo"`
-				Flag    string `json:"flag"`
-				Season  int    `json:"season"`
-			} `json:"league"`
-			Games struct {
-				Appearances int    `json:"appearences"`
-				Lineups     int    `json:"lineups"`
-				Minutes     int    `json:"minutes"`
-				Number      int    `json:"number"`
-				Position    string `json:"position"`
-				Rating      string `json:"rating"`
-				Captain     bool   `json:"captain"`
-			} `json:"games"`
-			Substitutes struct {
-				In    int `json:"in"`
-				Out   int `json:"out"`
-				Bench int `json:"bench"`
-			} `json:"substitutes"`
-			Shots struct {
-				Total int `json:"total"`
-				On    int `json:"on"`
-			} `json:"shots"`
-			Goals struct {
-				Total    int `json:"total"`
-				Conceded int `json:"conceded"`
-				Assists  int `json:"assists"`
-				Saves    int `json:"saves"`
-			} `json:"goals"`
-			Passes struct {
-				Total    int `json:"total"`
-				Key      int `json:"key"`
-				Accuracy int `json:"accuracy"`
-			} `json:"passes"`
-			Tackles struct {
-				Total         int `json:"total"`
-				Blocks        int `json:"blocks"`
-				Interceptions int `json:"interceptions"`
-			} `json:"tackles"`
-			Duels struct {
-				Total int `json:"total"`
-				Won   int `json:"won"`
-			} `json:"duels"`
-			Dribbles struct {
-				Attempts int `json:"attempts"`
-				Success  int `json:"success"`
-				Past     int `json:"past"`
-			} `json:"dribbles"`
-			Fouls struct {
-				Drawn     int `json:"drawn"`
-				Committed int `json:"committed"`
-			} `json:"fouls"`
-			Cards struct {
-				Yellow    int `json:"yellow"`
-				Yellowred int `json:"yellowred"`
-				Red       int `json:"red"`
-			} `json:"cards"`
-			Penalty struct {
-				Won       int `json:"won"`
-				Committed int `json:"commited"`
-				Scored    int `json:"scored"`
-				Missed    int `json:"missed"`
-				Saved     int `json:"saved"`
-			} `json:"penalty"`
-		} `json:"statistics"`
-	} `json:"response"`
-}
-
-// PlayersSearchResponse is the response from the /players/search endpoint
+	Envelope[[]PlayerWithStats]
+}
+
+// PlayersSquadsResponse is the response from the /players/squads endpoint
 type PlayersSquadsResponse struct {
-	Get        string     `json:"get"`
-	Parameters any        `json:"parameters"`
-	Errors     any        `json:"errors"`
-	Results    int        `json:"results"`
-	Paging     Pagination `json:"paging"`
-	Response   []struct {
-		Team struct {
-			ID   int    `json:"id"`
-			Name string `json:"name"`
-			Logo string `json:"logo"`
-		} `json:"team"`
-		Players []struct {
-			ID       int    `json:"id"`
-			Name     string `json:"name"`
-			Age      int    `json:"age"`
-			Number   int    `json:"number"`
-			Position string `json:"position"`
-			Photo    string `json:"photo"`
-		} `json:"players"`
-	} `json:"response"`
+	Envelope[[]TeamSquad]
+}
+
+// TeamSquad is the current squad of a team.
+type TeamSquad struct {
+	Team    TeamRef       `json:"team"`
+	Players []SquadPlayer `json:"players"`
+}
+
+// SquadPlayer is a player in a squad.
+type SquadPlayer struct {
+	ID       int    `json:"id"`
+	Name     string `json:"name"`
+	Age      int    `json:"age"`
+	Number   int    `json:"number"`
+	Position string `json:"position"`
+	Photo    string `json:"photo"`
 }
 
 // PlayersTopResponse is the response from the /players/topscorers, /players/topassists,
 // /players/topyellowcards, /players/topredcards endpoints
 type PlayersTopResponse struct {
-	Get        string `json:"get"`
-	Parameters struct {
-		League string `json:"league"`
-		Season string `json:"season"`
-	} `json:"parameters"`
-	Errors   []any      `json:"errors"`
-	Results  int        `json:"results"`
-	Paging   Pagination `json:"paging"`
-	Response []struct {
-		Player struct {
-			ID        int    `json:"id"`
-			Name      string `json:"name"`
-			Firstname string `json:"firstname"`
-			Lastname  string `json:"lastname"`
-			Age       int    `json:"age"`
-			Birth     struct {
-				Date    string `json:"date"`
-				Place   string `json:"place"`
-				Country string `json:"country"`
-			} `json:"birth"`
-			Nationality string `json:"nationality"`
-			Height      string `json:"height"`
-			Weight      string `json:"weight"`
-			Injured     bool   `json:"injured"`
-			Photo       string `json:"photo"`
-		} `json:"player"`
-		Statistics []struct {
-			Team struct {
-				ID   int    `json:"id"`
-				Name string `json:"name"`
-				Logo string `json:"logo"`
-			} `json:"team"`
-			League struct {
-				ID      int    `json:"id"`
-				Name    string `json:"name"`
-				Country string `json:"country"`
-				Logo    string `json:"logo"`
-				Flag    string `json:"flag"`
-				Season  int    `json:"season"`
-			} `json:"league"`
-			Games struct {
-				Appearances int    `json:"appearences"`
-				Lineups     int    `json:"lineups"`
-				Minutes     int    `json:"minutes"`
-				Number      int    `json:"number"`
-				Position    string `json:"position"`
-				Rating      string `json:"rating"`
-				Captain     bool   `json:"captain"`
-			} `json:"games"`
-			Substitutes struct {
-				In    int `json:"in"`
-				Out   int `json:"out"`
-				Bench int `json:"bench"`
-			} `json:"substitutes"`
-			Shots struct {
-				Total int `json:"total"`
-				On    int `json:"on"`
-			} `json:"shots"`
-			Goals struct {
-				Total    int `json:"total"`
-				Conceded int `json:"conceded"`
-				Assists  int `json:"assists"`
-				Saves    int `json:"saves"`
-			} `json:"goals"`
-			Passes struct {
-				Total    int `json:"total"`
-				Key      int `json:"key"`
-				Accuracy int `json:"accuracy"`
-			} `json:"passes"`
-			Tackles struct {
-				Total         int `json:"total"`
-				Blocks        int `json:"blocks"`
-				Interceptions int `json:"interceptions"`
-			} `json:"tackles"`
-			Duels struct {
-				Total int `json:"total"`
-				Won   int `json:"won"`
-			} `json:"duels"`
-			Dribbles struct {
-				Attempts int `json:"attempts"`
-				Success  int `json:"success"`
-				Past     int `json:"past"`
-			} `json:"dribbles"`
-			Fouls struct {
-				Drawn     int `json:"drawn"`
-				Committed int `json:"committed"`
-			} `json:"fouls"`
-			Cards struct {
-				Yellow    int `json:"yellow"`
-				Yellowred int `json:"yellowred"`
-				Red       int `json:"red"`
-			} `json:"cards"`
-			Penalty struct {
-				Won       int `json:"won"`
-				Committed int `json:"commited"`
-				Scored    int `json:"scored"`
-				Missed    int `json:"missed"`
-				Saved     int `json:"saved"`
-			} `json:"penalty"`
-		} `json:"statistics"`
-	} `json:"response"`
+	Envelope[[]PlayerWithStats]
+}
+
+// PlayerWithStats is a player together with their statistics per team and
+// league, as returned by the /players and /players/top* endpoints.
+type PlayerWithStats struct {
+	Player     Player             `json:"player"`
+	Statistics []SeasonStatistics `json:"statistics"`
+}
+
+// Player is the profile of a player. Injured is only sent by the /players
+// endpoints, Number and Position only by /players/profiles.
+type Player struct {
+	ID          int    `json:"id"`
+	Name        string `json:"name"`
+	Firstname   string `json:"firstname"`
+	Lastname    string `json:"lastname"`
+	Age         int    `json:"age"`
+	Birth       Birth  `json:"birth"`
+	Nationality string `json:"nationality"`
+	Height      string `json:"height"`
+	Weight      string `json:"weight"`
+	Injured     bool   `json:"injured"`
+	Number      int    `json:"number"`
+	Position    string `json:"position"`
+	Photo       string `json:"photo"`
+}
+
+// SeasonStatistics are the statistics of a player for one team in one
+// league season.
+type SeasonStatistics struct {
+	Team   TeamRef   `json:"team"`
+	League LeagueRef `json:"league"`
+	Games  struct {
+		Appearances int    `json:"appearences"`
+		Lineups     int    `json:"lineups"`
+		Minutes     int    `json:"minutes"`
+		Number      int    `json:"number"`
+		Position    string `json:"position"`
+		Rating      string `json:"rating"`
+		Captain     bool   `json:"captain"`
+	} `json:"games"`
+	Substitutes struct {
+		In    int `json:"in"`
+		Out   int `json:"out"`
+		Bench int `json:"bench"`
+	} `json:"substitutes"`
+	Shots  ShotStats   `json:"shots"`
+	Goals  PlayerGoals `json:"goals"`
+	Passes struct {
+		Total    int `json:"total"`
+		Key      int `json:"key"`
+		Accuracy int `json:"accuracy"`
+	} `json:"passes"`
+	Tackles  TackleStats  `json:"tackles"`
+	Duels    DuelStats    `json:"duels"`
+	Dribbles DribbleStats `json:"dribbles"`
+	Fouls    FoulStats    `json:"fouls"`
+	Cards    struct {
+		Yellow    int `json:"yellow"`
+		Yellowred int `json:"yellowred"`
+		Red       int `json:"red"`
+	} `json:"cards"`
+	Penalty PenaltyStats `json:"penalty"`
+}
+
+// Rating returns Games.Rating as a number. ok is false when the player has
+// no rating.
+func (s SeasonStatistics) Rating() (float64, bool) {
+	return FlexString(s.Games.Rating).Float()
+}
+
+// ShotStats counts a player's shots.
+type ShotStats struct {
+	Total int `json:"total"`
+	On    int `json:"on"`
+}
+
+// PlayerGoals counts a player's goals, assists, and for goalkeepers goals
+// conceded and saves.
+type PlayerGoals struct {
+	Total    int `json:"total"`
+	Conceded int `json:"conceded"`
+	Assists  int `json:"assists"`
+	Saves    int `json:"saves"`
+}
+
+// TackleStats counts a player's tackles.
+type TackleStats struct {
+	Total         int `json:"total"`
+	Blocks        int `json:"blocks"`
+	Interceptions int `json:"interceptions"`
+}
+
+// DuelStats counts a player's duels.
+type DuelStats struct {
+	Total int `json:"total"`
+	Won   int `json:"won"`
+}
+
+// DribbleStats counts a player's dribbles.
+type DribbleStats struct {
+	Attempts int `json:"attempts"`
+	Success  int `json:"success"`
+	Past     int `json:"past"`
+}
+
+// FoulStats counts the fouls a player drew and committed.
+type FoulStats struct {
+	Drawn     int `json:"drawn"`
+	Committed int `json:"committed"`
+}
+
+// PenaltyStats counts a player's penalties. The API spells the committed
+// field "commited".
+type PenaltyStats struct {
+	Won       int `json:"won"`
+	Committed int `json:"commited"`
+	Scored    int `json:"scored"`
+	Missed    int `json:"missed"`
+	Saved     int `json:"saved"`
 }
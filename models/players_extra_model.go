@@ -2,46 +2,21 @@ package models
 
 // PlayersProfilesResponse is the response from the /players/profiles endpoint.
 type PlayersProfilesResponse struct {
-	Get        string     `json:"get"`
-	Parameters any        `json:"parameters"`
-	Errors     any        `json:"errors"`
-	Results    int        `json:"results"`
-	Paging     Pagination `json:"paging"`
-	Response   []struct {
-		Player struct {
-			ID        int    `json:"id"`
-			Name      string `json:"name"`
-			Firstname string `json:"firstname"`
-			Lastname  string `json:"lastname"`
-			Age       int    `json:"age"`
-			Birth     struct {
-				Date    string `json:"date"`
-				Place   string `json:"place"`
-				Country string `json:"country"`
-			} `json:"birth"`
-			Nationality string `json:"nationality"`
-			Height      string `json:"height"`
-			Weight      string `json:"weight"`
-			Number      int    `json:"number"`
-			Position    string `json:"position"`
-			Photo       string `json:"photo"`
-		} `json:"player"`
-	} `json:"response"`
+	Envelope[[]PlayerProfile]
 }
 
 // PlayersTeamsResponse is the response from the /players/teams endpoint.
 type PlayersTeamsResponse struct {
-	Get        string     `json:"get"`
-	Parameters any        `json:"parameters"`
-	Errors     any        `json:"errors"`
-	Results    int        `json:"results"`
-	Paging     Pagination `json:"paging"`
-	Response   []struct {
-		Team struct {
-			ID   int    `json:"id"`
-			Name string `json:"name"`
-			Logo string `json:"logo"`
-		} `json:"team"`
-		Seasons []int `json:"seasons"`
-	} `json:"response"`
+	Envelope[[]PlayerTeam]
+}
+
+// PlayerTeam is a team a player played for, and the seasons they did.
+type PlayerTeam struct {
+	Team    TeamRef `json:"team"`
+	Seasons []int   `json:"seasons"`
+}
+
+// PlayerProfile is a single entry of the /players/profiles endpoint.
+type PlayerProfile struct {
+	Player Player `json:"player"`
 }
@@ -63,54 +63,74 @@ type PredictionTeam struct {
 	} `json:"league"`
 }
 
+// Last5Att returns Last5.Att, the attacking strength over the last five
+// fixtures, as a percentage.
+func (t PredictionTeam) Last5Att() (float64, bool) {
+	return FlexString(t.Last5.Att).Percent()
+}
+
+// Last5Def returns Last5.Def, the defensive strength over the last five
+// fixtures, as a percentage.
+func (t PredictionTeam) Last5Def() (float64, bool) {
+	return FlexString(t.Last5.Def).Percent()
+}
+
 // PredictionsResponse is the response from the /predictions endpoint.
 type PredictionsResponse struct {
-	Get        string     `json:"get"`
-	Parameters any        `json:"parameters"`
-	Errors     any        `json:"errors"`
-	Results    int        `json:"results"`
-	Paging     Pagination `json:"paging"`
-	Response   []struct {
-		Predictions struct {
-			Winner struct {
-				ID      int    `json:"id"`
-				Name    string `json:"name"`
-				Comment string `json:"comment"`
-			} `json:"winner"`
-			WinOrDraw bool   `json:"win_or_draw"`
-			UnderOver string `json:"under_over"`
-			Goals     struct {
-				Home string `json:"home"`
-				Away string `json:"away"`
-			} `json:"goals"`
-			Advice  string `json:"advice"`
-			Percent struct {
-				Home string `json:"home"`
-				Draw string `json:"draw"`
-				Away string `json:"away"`
-			} `json:"percent"`
-		} `json:"predictions"`
-		League struct {
+	Envelope[[]Prediction]
+}
+
+// Prediction is the prediction, comparison and head-to-head of one fixture.
+type Prediction struct {
+	Predictions struct {
+		Winner struct {
 			ID      int    `json:"id"`
 			Name    string `json:"name"`
-			Country string `json:"country"`
-			Logo    string `json:"logo"`
-			Flag    string `json:"flag"`
-			Season  int    `json:"season"`
-		} `json:"league"`
-		Teams struct {
-			Home PredictionTeam `json:"home"`
-			Away PredictionTeam `json:"away"`
-		} `json:"teams"`
-		Comparison struct {
-			Form                HomeAwayString `json:"form"`
-			Att                 HomeAwayString `json:"att"`
-			Def                 HomeAwayString `json:"def"`
-			PoissonDistribution HomeAwayString `json:"poisson_distribution"`
-			H2H                 HomeAwayString `json:"h2h"`
-			Goals               HomeAwayString `json:"goals"`
-			Total               HomeAwayString `json:"total"`
-		} `json:"comparison"`
-		H2H []FixtureResp `json:"h2h"`
-	} `json:"response"`
+			Comment string `json:"comment"`
+		} `json:"winner"`
+		WinOrDraw bool   `json:"win_or_draw"`
+		UnderOver string `json:"under_over"`
+		Goals     struct {
+			Home string `json:"home"`
+			Away string `json:"away"`
+		} `json:"goals"`
+		Advice  string `json:"advice"`
+		Percent struct {
+			Home string `json:"home"`
+			Draw string `json:"draw"`
+			Away string `json:"away"`
+		} `json:"percent"`
+	} `json:"predictions"`
+	League LeagueRef `json:"league"`
+	Teams  struct {
+		Home PredictionTeam `json:"home"`
+		Away PredictionTeam `json:"away"`
+	} `json:"teams"`
+	Comparison struct {
+		Form                HomeAwayString `json:"form"`
+		Att                 HomeAwayString `json:"att"`
+		Def                 HomeAwayString `json:"def"`
+		PoissonDistribution HomeAwayString `json:"poisson_distribution"`
+		H2H                 HomeAwayString `json:"h2h"`
+		Goals               HomeAwayString `json:"goals"`
+		Total               HomeAwayString `json:"total"`
+	} `json:"comparison"`
+	H2H []Fixture `json:"h2h"`
+}
+
+// Percents returns Predictions.Percent as numbers, 45 for "45%". ok is
+// false if any of them is missing or malformed.
+func (p Prediction) Percents() (home, draw, away float64, ok bool) {
+	home, okHome := FlexString(p.Predictions.Percent.Home).Percent()
+	draw, okDraw := FlexString(p.Predictions.Percent.Draw).Percent()
+	away, okAway := FlexString(p.Predictions.Percent.Away).Percent()
+	return home, draw, away, okHome && okDraw && okAway
+}
+
+// PredictedGoals returns Predictions.Goals, the predicted goal lines such
+// as "-2.5", as numbers. ok is false if either is missing or malformed.
+func (p Prediction) PredictedGoals() (home, away float64, ok bool) {
+	home, okHome := FlexString(p.Predictions.Goals.Home).Float()
+	away, okAway := FlexString(p.Predictions.Goals.Away).Float()
+	return home, away, okHome && okAway
 }
@@ -0,0 +1,68 @@
+package models
+
+import "time"
+
+// TeamRef identifies a team wherever the API nests one in a response.
+type TeamRef struct {
+	ID   int    `json:"id"`
+	Name string `json:"name"`
+	Logo string `json:"logo"`
+}
+
+// LeagueRef identifies a league season wherever the API nests one in a
+// response. Endpoints that do not send some of the fields leave them empty.
+type LeagueRef struct {
+	ID      int    `json:"id"`
+	Name    string `json:"name"`
+	Country string `json:"country"`
+	Logo    string `json:"logo"`
+	Flag    string `json:"flag"`
+	Season  int    `json:"season"`
+}
+
+// PlayerRef identifies a player in events and transfers.
+type PlayerRef struct {
+	ID   int    `json:"id"`
+	Name string `json:"name"`
+}
+
+// CoachRef identifies a coach in lineups.
+type CoachRef struct {
+	ID    int    `json:"id"`
+	Name  string `json:"name"`
+	Photo string `json:"photo"`
+}
+
+// FixtureRef identifies a fixture in injuries.
+type FixtureRef struct {
+	ID        int       `json:"id"`
+	Timezone  string    `json:"timezone"`
+	Date      time.Time `json:"date"`
+	Timestamp int       `json:"timestamp"`
+}
+
+// Birth is the date and place of birth of a player or coach.
+type Birth struct {
+	Date    string `json:"date"`
+	Place   string `json:"place"`
+	Country string `json:"country"`
+}
+
+// Venue is a stadium as returned by the /venues and /teams endpoints.
+type Venue struct {
+	ID       int    `json:"id"`
+	Name     string `json:"name"`
+	Address  string `json:"address"`
+	City     string `json:"city"`
+	Country  string `json:"country"`
+	Capacity int    `json:"capacity"`
+	Surface  string `json:"surface"`
+	Image    string `json:"image"`
+}
+
+// VenueRef identifies the venue of a fixture.
+type VenueRef struct {
+	ID   int    `json:"id"`
+	Name string `json:"name"`
+	City string `json:"city"`
+}
@@ -2,14 +2,12 @@ package models
 
 // SidelinedResponse is the response from the /sidelined endpoint
 type SidelinedResponse struct {
-	Get        string     `json:"get"`
-	Parameters any        `json:"parameters"`
-	Errors     any        `json:"errors"`
-	Results    int        `json:"results"`
-	Paging     Pagination `json:"paging"`
-	Response   []struct {
-		Type  string `json:"type"`
-		Start string `json:"start"`
-		End   string `json:"end"`
-	} `json:"response"`
+	Envelope[[]Sideline]
+}
+
+// Sideline is a period a player or coach was unavailable.
+type Sideline struct {
+	Type  string `json:"type"`
+	Start string `json:"start"`
+	End   string `json:"end"`
 }
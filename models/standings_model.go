@@ -4,64 +4,48 @@ import "time"
 
 // StandingsResponse is the response from the /standings endpoint.
 type StandingsResponse struct {
-	Get        string     `json:"get"`
-	Parameters any        `json:"parameters"`
-	Errors     any        `json:"errors"`
-	Results    int        `json:"results"`
-	Paging     Pagination `json:"paging"`
-	Response   []struct {
-		League struct {
-			ID        int    `json:"id"`
-			Name      string `json:"name"`
-			Country   string `json:"country"`
-			Logo      string `json:"logo"`
-			Flag      string `json:"flag"`
-			Season    int    `json:"season"`
-			Standings [][]struct {
-				Rank int `json:"rank"`
-				Team struct {
-					ID   int    `json:"id"`
-					Name string `json:"name"`
-					Logo string `json:"logo"`
-				} `json:"team"`
-				Points      int    `json:"points"`
-				GoalsDiff   int    `json:"goalsDiff"`
-				Group       string `json:"group"`
-				Form        string `json:"form"`
-				Status      string `json:"status"`
-				Description string `json:"description"`
-				All         struct {
-					Played int `json:"played"`
-					Win    int `json:"win"`
-					Draw   int `json:"draw"`
-					Lose   int `json:"lose"`
-					Goals  struct {
-						For     int `json:"for"`
-						Against int `json:"against"`
-					} `json:"goals"`
-				} `json:"all"`
-				Home struct {
-					Played int `json:"played"`
-					Win    int `json:"win"`
-					Draw   int `json:"draw"`
-					Lose   int `json:"lose"`
-					Goals  struct {
-						For     int `json:"for"`
-						Against int `json:"against"`
-					} `json:"goals"`
-				} `json:"home"`
-				Away struct {
-					Played int `json:"played"`
-					Win    int `json:"win"`
-					Draw   int `json:"draw"`
-					Lose   int `json:"lose"`
-					Goals  struct {
-						For     int `json:"for"`
-						Against int `json:"against"`
-					} `json:"goals"`
-				} `json:"away"`
-				Update time.Time `json:"update"`
-			} `json:"standings"`
-		} `json:"league"`
-	} `json:"response"`
+	Envelope[[]Standings]
+}
+
+// Standings holds the standings of one league season.
+type Standings struct {
+	League LeagueStandings `json:"league"`
+}
+
+// LeagueStandings is a league season with its standings. Standings holds
+// one table per group; leagues without groups have a single table.
+type LeagueStandings struct {
+	LeagueRef
+	Standings [][]StandingRow `json:"standings"`
+}
+
+// StandingRow is the row of one team in a standings table.
+type StandingRow struct {
+	Rank        int            `json:"rank"`
+	Team        TeamRef        `json:"team"`
+	Points      int            `json:"points"`
+	GoalsDiff   int            `json:"goalsDiff"`
+	Group       string         `json:"group"`
+	Form        string         `json:"form"`
+	Status      string         `json:"status"`
+	Description string         `json:"description"`
+	All         StandingRecord `json:"all"`
+	Home        StandingRecord `json:"home"`
+	Away        StandingRecord `json:"away"`
+	Update      time.Time      `json:"update"`
+}
+
+// StandingRecord is a team's record over all, home or away matches.
+type StandingRecord struct {
+	Played int             `json:"played"`
+	Win    int             `json:"win"`
+	Draw   int             `json:"draw"`
+	Lose   int             `json:"lose"`
+	Goals  GoalsForAgainst `json:"goals"`
+}
+
+// GoalsForAgainst counts goals scored and conceded.
+type GoalsForAgainst struct {
+	For     int `json:"for"`
+	Against int `json:"against"`
 }
@@ -4,32 +4,22 @@ import "time"
 
 // TransfersResponse is the response from the /transfers endpoint
 type TransfersResponse struct {
-	Get        string     `json:"get"`
-	Parameters any        `json:"parameters"`
-	Errors     any        `json:"errors"`
-	Results    int        `json:"results"`
-	Paging     Pagination `json:"paging"`
-	Response   []struct {
-		Player struct {
-			ID   int    `json:"id"`
-			Name string `json:"name"`
-		} `json:"player"`
-		Update    time.Time `json:"update"`
-		Transfers []struct {
-			Date  string `json:"date"`
-			Type  string `json:"type"`
-			Teams struct {
-				In struct {
-					ID   int    `json:"id"`
-					Name string `json:"name"`
-					Logo string `json:"logo"`
-				} `json:"in"`
-				Out struct {
-					ID   int    `json:"id"`
-					Name string `json:"name"`
-					Logo string `json:"logo"`
-				} `json:"out"`
-			} `json:"teams"`
-		} `json:"transfers"`
-	} `json:"response"`
+	Envelope[[]PlayerTransfers]
+}
+
+// PlayerTransfers is the transfer history of a player.
+type PlayerTransfers struct {
+	Player    PlayerRef  `json:"player"`
+	Update    time.Time  `json:"update"`
+	Transfers []Transfer `json:"transfers"`
+}
+
+// Transfer is a single move of a player between two teams.
+type Transfer struct {
+	Date  string `json:"date"`
+	Type  string `json:"type"`
+	Teams struct {
+		In  TeamRef `json:"in"`
+		Out TeamRef `json:"out"`
+	} `json:"teams"`
 }
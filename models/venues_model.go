@@ -2,19 +2,5 @@ package models
 
 // VenuesResponse is the response from the /venues endpoint
 type VenuesResponse struct {
-	Get        string     `json:"get"`
-	Parameters any        `json:"parameters"`
-	Errors     any        `json:"errors"`
-	Results    int        `json:"results"`
-	Paging     Pagination `json:"paging"`
-	Response   []struct {
-		ID       int    `json:"id"`
-		Name     string `json:"name"`
-		Address  string `json:"address"`
-		City     string `json:"city"`
-		Country  string `json:"country"`
-		Capacity int    `json:"capacity"`
-		Surface  string `json:"surface"`
-		Image    string `json:"image"`
-	} `json:"response"`
+	Envelope[[]Venue]
 }
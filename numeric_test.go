@@ -0,0 +1,141 @@
+package client_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/0ffsideCompass/api-football-go-client/models"
+)
+
+func TestFlexStringNumbers(t *testing.T) {
+	tests := []struct {
+		value        models.FlexString
+		isNull       bool
+		intValue     int
+		intOK        bool
+		floatValue   float64
+		floatOK      bool
+		percentValue float64
+		percentOK    bool
+	}{
+		{value: "", isNull: true},
+		{value: "0", intOK: true, floatOK: true, percentOK: true},
+		{value: "12", intValue: 12, intOK: true, floatValue: 12, floatOK: true, percentValue: 12, percentOK: true},
+		{value: "7.3", floatValue: 7.3, floatOK: true, percentValue: 7.3, percentOK: true},
+		{value: "45%", percentValue: 45, percentOK: true},
+		{value: "n/a"},
+	}
+
+	for _, tt := range tests {
+		t.Run(string(tt.value), func(t *testing.T) {
+			assert.Equal(t, tt.isNull, tt.value.IsNull())
+
+			n, ok := tt.value.Int()
+			assert.Equal(t, tt.intValue, n)
+			assert.Equal(t, tt.intOK, ok)
+
+			f, ok := tt.value.Float()
+			assert.Equal(t, tt.floatValue, f)
+			assert.Equal(t, tt.floatOK, ok)
+
+			p, ok := tt.value.Percent()
+			assert.Equal(t, tt.percentValue, p)
+			assert.Equal(t, tt.percentOK, ok)
+		})
+	}
+}
+
+func TestDecimal(t *testing.T) {
+	tests := []struct {
+		input   string
+		want    string
+		float   float64
+		wantErr string
+	}{
+		{input: "1.85", want: "1.85", float: 1.85},
+		{input: "7.00", want: "7", float: 7},
+		{input: "2.375", want: "2.375", float: 2.375},
+		{input: "-0.25", want: "-0.25", float: -0.25},
+		{input: ".5", want: "0.5", float: 0.5},
+		{input: "1001", want: "1001", float: 1001},
+		{input: "", wantErr: `invalid decimal ""`},
+		{input: "1.2.3", wantErr: `invalid decimal "1.2.3"`},
+		{input: "1,85", wantErr: `invalid decimal "1,85"`},
+		{input: "1.0000001", wantErr: `decimal "1.0000001" has more than 6 fractional digits`},
+		{input: "99999999999999", wantErr: `decimal "99999999999999" out of range`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			d, err := models.ParseDecimal(tt.input)
+			if tt.wantErr != "" {
+				assert.EqualError(t, err, tt.wantErr)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, d.String())
+			assert.Equal(t, tt.float, d.Float64())
+		})
+	}
+
+	low, _ := models.ParseDecimal("1.85")
+	high, _ := models.ParseDecimal("1.850001")
+	assert.Equal(t, -1, low.Cmp(high))
+	assert.Equal(t, 1, high.Cmp(low))
+	assert.Equal(t, 0, low.Cmp(low))
+	assert.True(t, models.Decimal{}.IsZero())
+
+	odd, ok := models.FlexString("2.10").Decimal()
+	assert.True(t, ok)
+	assert.Equal(t, "2.1", odd.String())
+	_, ok = models.FlexString("").Decimal()
+	assert.False(t, ok)
+
+	text, err := odd.MarshalText()
+	assert.NoError(t, err)
+	var decoded models.Decimal
+	assert.NoError(t, decoded.UnmarshalText(text))
+	assert.Equal(t, odd, decoded)
+}
+
+func TestModelNumericFields(t *testing.T) {
+	body := `{
+		"get": "players", "errors": [], "results": 1, "paging": {"current": 1, "total": 1},
+		"response": [{
+			"player": {"id": 276, "name": "Neymar"},
+			"statistics": [{"games": {"rating": "7.466666", "minutes": 1200}}]
+		}]
+	}`
+	resp, err := newTestClient(t, body).Players(map[string]any{"id": 276, "season": 2019})
+	assert.NoError(t, err)
+
+	rating, ok := resp.Response[0].Statistics[0].Rating()
+	assert.True(t, ok)
+	assert.InDelta(t, 7.466666, rating, 1e-9)
+
+	var stat models.MinuteStat
+	_, ok = stat.Percent()
+	assert.False(t, ok, "a missing percentage is not zero")
+
+	var match models.MatchStatistics
+	match.Passes.Accuracy = "68%"
+	accuracy, ok := match.PassAccuracy()
+	assert.True(t, ok)
+	assert.Equal(t, 68.0, accuracy)
+	_, ok = match.Rating()
+	assert.False(t, ok, "an unused substitute has no rating")
+
+	var team models.TeamStatistics
+	team.Penalty.Scored.Percentage = "80.00%"
+	scored, ok := team.PenaltyScoredPercent()
+	assert.True(t, ok)
+	assert.Equal(t, 80.0, scored)
+}
+
+func decimal(t *testing.T, s string) models.Decimal {
+	t.Helper()
+	d, err := models.ParseDecimal(s)
+	assert.NoError(t, err)
+	return d
+}
@@ -1,6 +1,7 @@
 package client
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 
@@ -38,8 +39,22 @@ const (
 func (c *Client) Odds(
 	params map[string]any,
 ) (*models.OddsResponse, error) {
+	return c.OddsContext(context.Background(), params)
+}
+
+// OddsContext is like Odds but with a context.
+func (c *Client) OddsContext(
+	ctx context.Context,
+	params map[string]any,
+) (*models.OddsResponse, error) {
+	// Validate the parameters
+	if err := c.validateParams(oddsEndpoint, params); err != nil {
+		return nil, err
+	}
+
 	endpointURL := fmt.Sprintf("%s%s", c.Domain, oddsEndpoint)
 	body, err := c.get(
+		ctx,
 		c.buildURL(
 			endpointURL,
 			params,
@@ -61,6 +76,11 @@ func (c *Client) Odds(
 	return &resp, nil
 }
 
+// OddsWith is like OddsContext but takes typed parameters.
+func (c *Client) OddsWith(ctx context.Context, params OddsParams) (*models.OddsResponse, error) {
+	return c.OddsContext(ctx, params.Encode())
+}
+
 // OddsMapping hits the /odds/mapping endpoint. It returns the list of fixture
 // IDs for which pre-match odds are available.
 /*
@@ -70,8 +90,22 @@ func (c *Client) Odds(
 func (c *Client) OddsMapping(
 	params map[string]any,
 ) (*models.OddsMappingResponse, error) {
+	return c.OddsMappingContext(context.Background(), params)
+}
+
+// OddsMappingContext is like OddsMapping but with a context.
+func (c *Client) OddsMappingContext(
+	ctx context.Context,
+	params map[string]any,
+) (*models.OddsMappingResponse, error) {
+	// Validate the parameters
+	if err := c.validateParams(oddsMappingEndpoint, params); err != nil {
+		return nil, err
+	}
+
 	endpointURL := fmt.Sprintf("%s%s", c.Domain, oddsMappingEndpoint)
 	body, err := c.get(
+		ctx,
 		c.buildURL(
 			endpointURL,
 			params,
@@ -93,6 +127,11 @@ func (c *Client) OddsMapping(
 	return &resp, nil
 }
 
+// OddsMappingWith is like OddsMappingContext but takes typed parameters.
+func (c *Client) OddsMappingWith(ctx context.Context, params OddsMappingParams) (*models.OddsMappingResponse, error) {
+	return c.OddsMappingContext(ctx, params.Encode())
+}
+
 // OddsBookmakers hits the /odds/bookmakers endpoint. It returns the list of
 // available bookmakers for the pre-match odds endpoint.
 /*
@@ -104,8 +143,22 @@ func (c *Client) OddsMapping(
 func (c *Client) OddsBookmakers(
 	params map[string]any,
 ) (*models.OddsBookmakersResponse, error) {
+	return c.OddsBookmakersContext(context.Background(), params)
+}
+
+// OddsBookmakersContext is like OddsBookmakers but with a context.
+func (c *Client) OddsBookmakersContext(
+	ctx context.Context,
+	params map[string]any,
+) (*models.OddsBookmakersResponse, error) {
+	// Validate the parameters
+	if err := c.validateParams(oddsBookmakersEndpoint, params); err != nil {
+		return nil, err
+	}
+
 	endpointURL := fmt.Sprintf("%s%s", c.Domain, oddsBookmakersEndpoint)
 	body, err := c.get(
+		ctx,
 		c.buildURL(
 			endpointURL,
 			params,
@@ -127,6 +180,11 @@ func (c *Client) OddsBookmakers(
 	return &resp, nil
 }
 
+// OddsBookmakersWith is like OddsBookmakersContext but takes typed parameters.
+func (c *Client) OddsBookmakersWith(ctx context.Context, params OddsBookmakersParams) (*models.OddsBookmakersResponse, error) {
+	return c.OddsBookmakersContext(ctx, params.Encode())
+}
+
 // OddsBets hits the /odds/bets endpoint. It returns the list of available bet
 // types for the pre-match odds endpoint.
 /*
@@ -138,8 +196,22 @@ func (c *Client) OddsBookmakers(
 func (c *Client) OddsBets(
 	params map[string]any,
 ) (*models.OddsBetsResponse, error) {
+	return c.OddsBetsContext(context.Background(), params)
+}
+
+// OddsBetsContext is like OddsBets but with a context.
+func (c *Client) OddsBetsContext(
+	ctx context.Context,
+	params map[string]any,
+) (*models.OddsBetsResponse, error) {
+	// Validate the parameters
+	if err := c.validateParams(oddsBetsEndpoint, params); err != nil {
+		return nil, err
+	}
+
 	endpointURL := fmt.Sprintf("%s%s", c.Domain, oddsBetsEndpoint)
 	body, err := c.get(
+		ctx,
 		c.buildURL(
 			endpointURL,
 			params,
@@ -161,6 +233,11 @@ func (c *Client) OddsBets(
 	return &resp, nil
 }
 
+// OddsBetsWith is like OddsBetsContext but takes typed parameters.
+func (c *Client) OddsBetsWith(ctx context.Context, params OddsBetsParams) (*models.OddsBetsResponse, error) {
+	return c.OddsBetsContext(ctx, params.Encode())
+}
+
 // OddsLive hits the /odds/live endpoint. It returns in-play odds for fixtures
 // that are currently in progress.
 /*
@@ -174,8 +251,22 @@ func (c *Client) OddsBets(
 func (c *Client) OddsLive(
 	params map[string]any,
 ) (*models.OddsLiveResponse, error) {
+	return c.OddsLiveContext(context.Background(), params)
+}
+
+// OddsLiveContext is like OddsLive but with a context.
+func (c *Client) OddsLiveContext(
+	ctx context.Context,
+	params map[string]any,
+) (*models.OddsLiveResponse, error) {
+	// Validate the parameters
+	if err := c.validateParams(oddsLiveEndpoint, params); err != nil {
+		return nil, err
+	}
+
 	endpointURL := fmt.Sprintf("%s%s", c.Domain, oddsLiveEndpoint)
 	body, err := c.get(
+		ctx,
 		c.buildURL(
 			endpointURL,
 			params,
@@ -197,6 +288,11 @@ func (c *Client) OddsLive(
 	return &resp, nil
 }
 
+// OddsLiveWith is like OddsLiveContext but takes typed parameters.
+func (c *Client) OddsLiveWith(ctx context.Context, params OddsLiveParams) (*models.OddsLiveResponse, error) {
+	return c.OddsLiveContext(ctx, params.Encode())
+}
+
 // OddsLiveBets hits the /odds/live/bets endpoint. It returns the list of
 // available bet types for the in-play odds endpoint.
 /*
@@ -208,8 +304,22 @@ func (c *Client) OddsLive(
 func (c *Client) OddsLiveBets(
 	params map[string]any,
 ) (*models.OddsBetsResponse, error) {
+	return c.OddsLiveBetsContext(context.Background(), params)
+}
+
+// OddsLiveBetsContext is like OddsLiveBets but with a context.
+func (c *Client) OddsLiveBetsContext(
+	ctx context.Context,
+	params map[string]any,
+) (*models.OddsBetsResponse, error) {
+	// Validate the parameters
+	if err := c.validateParams(oddsLiveBetsEndpoint, params); err != nil {
+		return nil, err
+	}
+
 	endpointURL := fmt.Sprintf("%s%s", c.Domain, oddsLiveBetsEndpoint)
 	body, err := c.get(
+		ctx,
 		c.buildURL(
 			endpointURL,
 			params,
@@ -230,3 +340,8 @@ func (c *Client) OddsLiveBets(
 
 	return &resp, nil
 }
+
+// OddsLiveBetsWith is like OddsLiveBetsContext but takes typed parameters.
+func (c *Client) OddsLiveBetsWith(ctx context.Context, params OddsBetsParams) (*models.OddsBetsResponse, error) {
+	return c.OddsLiveBetsContext(ctx, params.Encode())
+}
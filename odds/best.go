@@ -0,0 +1,122 @@
+package odds
+
+import "github.com/0ffsideCompass/api-football-go-client/models"
+
+// BestPrice is the highest odd offered for a selection across bookmakers.
+type BestPrice struct {
+	Value       string
+	Odd         models.Decimal
+	BookmakerID int
+	Bookmaker   string
+}
+
+// BestMarket holds the best price of every selection of a bet of one
+// fixture at one line, across bookmakers.
+type BestMarket struct {
+	FixtureID int
+	BetID     int
+	Bet       string
+	Line      string
+	// Prices holds the best price of each selection, in the order the
+	// selections were first seen.
+	Prices []BestPrice
+	// Complete reports whether at least one bookmaker priced every
+	// selection in Prices. When none did, the selections may not cover
+	// every outcome, and the market is never reported as an arbitrage.
+	Complete bool
+}
+
+// Best groups markets by fixture, bet and line and returns the best price of
+// each selection, in the order the groups were first seen.
+func Best(markets []Market) []BestMarket {
+	type key struct {
+		fixtureID, betID int
+		line             string
+	}
+	var order []key
+	best := make(map[key]*BestMarket)
+	// maxPriced is the most selections a single bookmaker priced.
+	maxPriced := make(map[key]int)
+
+	for _, m := range markets {
+		k := key{m.FixtureID, m.BetID, m.Line}
+		bm, ok := best[k]
+		if !ok {
+			bm = &BestMarket{FixtureID: m.FixtureID, BetID: m.BetID, Bet: m.Bet, Line: m.Line}
+			best[k] = bm
+			order = append(order, k)
+		}
+		maxPriced[k] = max(maxPriced[k], len(m.Selections))
+
+		for _, s := range m.Selections {
+			price := BestPrice{Value: s.Value, Odd: s.Odd, BookmakerID: m.BookmakerID, Bookmaker: m.Bookmaker}
+			i := bm.index(s.Value)
+			switch {
+			case i < 0:
+				bm.Prices = append(bm.Prices, price)
+			case s.Odd.Cmp(bm.Prices[i].Odd) > 0:
+				bm.Prices[i] = price
+			}
+		}
+	}
+
+	out := make([]BestMarket, 0, len(order))
+	for _, k := range order {
+		bm := best[k]
+		bm.Complete = maxPriced[k] == len(bm.Prices)
+		out = append(out, *bm)
+	}
+	return out
+}
+
+// index returns the position of the selection labelled value in Prices, or
+// -1.
+func (b *BestMarket) index(value string) int {
+	for i, p := range b.Prices {
+		if p.Value == value {
+			return i
+		}
+	}
+	return -1
+}
+
+// Overround returns the sum of the probabilities implied by the best prices.
+func (b BestMarket) Overround() float64 {
+	var total float64
+	for _, p := range b.Prices {
+		total += ImpliedProbability(p.Odd)
+	}
+	return total
+}
+
+// IsArbitrage reports whether backing every selection at its best price
+// guarantees a profit: the market is Complete, has at least two selections,
+// and its Overround is below 1.
+func (b BestMarket) IsArbitrage() bool {
+	return b.Complete && len(b.Prices) >= 2 && b.Overround() < 1
+}
+
+// Profit returns the guaranteed return per unit staked when the stakes
+// follow Stakes, 1/Overround - 1. It is negative when the market is not an
+// arbitrage.
+func (b BestMarket) Profit() float64 {
+	overround := b.Overround()
+	if overround == 0 {
+		return 0
+	}
+	return 1/overround - 1
+}
+
+// Stakes splits total across the selections, in the order of Prices, so
+// that every outcome returns the same amount.
+func (b BestMarket) Stakes(total float64) []float64 {
+	overround := b.Overround()
+	stakes := make([]float64, len(b.Prices))
+	if overround == 0 {
+		return stakes
+	}
+	for i, p := range b.Prices {
+		stakes[i] = total * ImpliedProbability(p.Odd) / overround
+	}
+	return stakes
+}
@@ -0,0 +1,50 @@
+package odds_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/0ffsideCompass/api-football-go-client/odds"
+)
+
+func TestOddsBestPrices(t *testing.T) {
+	resp := response(t, oddsAnalysisBody)
+	best := odds.Best(odds.Markets(resp))
+	assert.Len(t, best, 4)
+
+	winner := best[0]
+	assert.Equal(t, 1, winner.BetID)
+	assert.Equal(t, "Bet365", winner.Prices[0].Bookmaker)
+	assert.Equal(t, "2.1", winner.Prices[0].Odd.String())
+	assert.Equal(t, "Bwin", winner.Prices[1].Bookmaker)
+	assert.Equal(t, "Bwin", winner.Prices[2].Bookmaker)
+	assert.True(t, winner.Complete)
+	assert.False(t, winner.IsArbitrage())
+	assert.Less(t, winner.Profit(), 0.0)
+
+	homeAway := best[1]
+	assert.Equal(t, "2.2", homeAway.Prices[0].Odd.String())
+	assert.Equal(t, "2.25", homeAway.Prices[1].Odd.String())
+	assert.True(t, homeAway.IsArbitrage())
+	assert.InDelta(t, 1/(1/2.2+1/2.25)-1, homeAway.Profit(), 1e-12)
+	stakes := homeAway.Stakes(100)
+	assert.InDelta(t, 100, stakes[0]+stakes[1], 1e-9)
+	assert.InDelta(t, stakes[0]*2.2, stakes[1]*2.25, 1e-9)
+
+	// A single selection is never an arbitrage, however long its price.
+	assert.False(t, best[2].IsArbitrage())
+	assert.Equal(t, "1xBet", best[2].Prices[0].Bookmaker)
+}
+
+func TestOddsBestPricesIncompleteMarket(t *testing.T) {
+	markets := []odds.Market{
+		{FixtureID: 1, BetID: 1, BookmakerID: 1, Selections: []odds.Selection{{Value: "Home", Odd: decimal(t, "3")}}},
+		{FixtureID: 1, BetID: 1, BookmakerID: 2, Selections: []odds.Selection{{Value: "Away", Odd: decimal(t, "3")}}},
+	}
+	best := odds.Best(markets)
+	assert.Len(t, best, 1)
+	assert.Less(t, best[0].Overround(), 1.0)
+	assert.False(t, best[0].Complete)
+	assert.False(t, best[0].IsArbitrage(), "no bookmaker priced every outcome")
+}
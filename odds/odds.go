@@ -0,0 +1,211 @@
+// Package odds analyzes the pre-match odds returned by the /odds endpoint:
+// implied probabilities, bookmaker margins, margin-free fair probabilities,
+// best prices across bookmakers, and arbitrage opportunities.
+//
+// Start from a response with Markets, which flattens it into one Market per
+// fixture, bookmaker, bet and line:
+//
+//	resp, err := cli.Odds(map[string]any{"fixture": 1208021})
+//	// ...
+//	markets := odds.Markets(resp)
+//	for _, m := range markets {
+//		fair, _ := m.FairShin()
+//		fmt.Println(m.Bookmaker, m.Bet, m.Margin(), fair)
+//	}
+//	for _, best := range odds.Best(markets) {
+//		if best.IsArbitrage() {
+//			fmt.Println("surebet:", best.Bet, best.Stakes(100))
+//		}
+//	}
+package odds
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+
+	"github.com/0ffsideCompass/api-football-go-client/models"
+)
+
+// Market is a bet of one fixture as priced by one bookmaker, such as the
+// "Match Winner" odds of Bwin. Its selections are the mutually exclusive
+// outcomes the probabilities and margins below are computed over.
+type Market struct {
+	FixtureID   int
+	BookmakerID int
+	Bookmaker   string
+	BetID       int
+	Bet         string
+	// Line is the goal line or handicap shared by the selections, such as
+	// "2.5" for "Over 2.5" and "Under 2.5". A handicap line is the home
+	// side's handicap, so "Home -1" and "Away +1" share the line "-1". It
+	// is empty for bets without lines.
+	Line       string
+	Selections []Selection
+}
+
+// Selection is an outcome of a market and its decimal odd.
+type Selection struct {
+	// Value is the outcome label, such as "Home", "Over 2.5" or "1:0".
+	Value string
+	Odd   models.Decimal
+}
+
+// Markets flattens an /odds response into its markets, in response order.
+// A bet offering several lines, such as "Goals Over/Under" or "Asian
+// Handicap", becomes one market per line, in the order the lines are first
+// seen; the line is the number ending a selection's label. Selections whose
+// odd is missing, malformed or not above 1 are left out, as are markets left
+// with no selections.
+func Markets(resp *models.OddsResponse) []Market {
+	var markets []Market
+	for _, fixture := range resp.Response {
+		for _, bookmaker := range fixture.Bookmakers {
+			for _, bet := range bookmaker.Bets {
+				first := len(markets)
+				for _, value := range bet.Values {
+					odd, ok := value.Price()
+					if !ok || odd.Float64() <= 1 {
+						continue
+					}
+					label := fmt.Sprint(value.Value)
+					line := lineOf(label)
+					i := first
+					for i < len(markets) && markets[i].Line != line {
+						i++
+					}
+					if i == len(markets) {
+						markets = append(markets, Market{
+							FixtureID:   fixture.Fixture.ID,
+							BookmakerID: bookmaker.ID,
+							Bookmaker:   bookmaker.Name,
+							BetID:       bet.ID,
+							Bet:         bet.Name,
+							Line:        line,
+						})
+					}
+					markets[i].Selections = append(markets[i].Selections, Selection{Value: label, Odd: odd})
+				}
+			}
+		}
+	}
+	return markets
+}
+
+// lineOf returns the line of a selection label: its last word if that is a
+// number, as in "Over 2.5" or "Home -1", and "" otherwise. The number is
+// normalized, so "+1" and "1" are the same line, and the handicap of an
+// "Away" selection is negated to the home side's, so "Away +1" is on the
+// line of "Home -1".
+func lineOf(label string) string {
+	i := strings.LastIndexByte(label, ' ')
+	if i < 0 {
+		return ""
+	}
+	v, err := strconv.ParseFloat(label[i+1:], 64)
+	if err != nil {
+		return ""
+	}
+	if strings.HasPrefix(label, "Away ") {
+		v = -v
+	}
+	if v == 0 {
+		v = 0 // -0 is the same line as 0
+	}
+	return strconv.FormatFloat(v, 'f', -1, 64)
+}
+
+// ImpliedProbability returns the probability implied by a decimal odd,
+// 1/odd. It is 0 for an odd of 0.
+func ImpliedProbability(odd models.Decimal) float64 {
+	if odd.IsZero() {
+		return 0
+	}
+	return 1 / odd.Float64()
+}
+
+// ImpliedProbabilities returns the implied probability of each selection, in
+// order. They include the bookmaker's margin, so they sum to Overround.
+func (m Market) ImpliedProbabilities() []float64 {
+	probs := make([]float64, len(m.Selections))
+	for i, s := range m.Selections {
+		probs[i] = ImpliedProbability(s.Odd)
+	}
+	return probs
+}
+
+// Overround returns the sum of the implied probabilities, 1.05 for a book
+// with a 5% margin.
+func (m Market) Overround() float64 {
+	return sum(m.ImpliedProbabilities())
+}
+
+// Margin returns the bookmaker's margin, Overround - 1. A negative margin
+// means the book can be backed for a guaranteed profit.
+func (m Market) Margin() float64 {
+	return m.Overround() - 1
+}
+
+// FairProportional returns margin-free probabilities obtained by scaling the
+// implied probabilities down until they sum to 1, which spreads the margin
+// over the selections in proportion to their probability.
+func (m Market) FairProportional() []float64 {
+	probs := m.ImpliedProbabilities()
+	total := sum(probs)
+	if total == 0 {
+		return probs
+	}
+	for i := range probs {
+		probs[i] /= total
+	}
+	return probs
+}
+
+// shinTolerance is how close to 1 the Shin probabilities must sum.
+const shinTolerance = 1e-12
+
+// FairShin returns margin-free probabilities using Shin's model, which
+// attributes the margin to the bookmaker protecting itself against insider
+// trading and so removes more of it from longshots than from favourites. z
+// is the estimated share of insider money. Books without a positive margin
+// fall back to FairProportional, with z = 0.
+func (m Market) FairShin() (probs []float64, z float64) {
+	implied := m.ImpliedProbabilities()
+	booksum := sum(implied)
+	if booksum <= 1 {
+		return m.FairProportional(), 0
+	}
+
+	// The probabilities sum to sqrt(booksum) > 1 at z = 0, and their sum
+	// decreases as z grows, so bisect on z.
+	shin := func(z float64) []float64 {
+		out := make([]float64, len(implied))
+		for i, p := range implied {
+			out[i] = (math.Sqrt(z*z+4*(1-z)*p*p/booksum) - z) / (2 * (1 - z))
+		}
+		return out
+	}
+	lo, hi := 0.0, 1.0
+	for range 200 {
+		z = (lo + hi) / 2
+		total := sum(shin(z))
+		if math.Abs(total-1) < shinTolerance {
+			break
+		}
+		if total > 1 {
+			lo = z
+		} else {
+			hi = z
+		}
+	}
+	return shin(z), z
+}
+
+func sum(values []float64) float64 {
+	var total float64
+	for _, v := range values {
+		total += v
+	}
+	return total
+}
@@ -0,0 +1,168 @@
+package odds_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/0ffsideCompass/api-football-go-client/models"
+	"github.com/0ffsideCompass/api-football-go-client/odds"
+)
+
+const oddsAnalysisBody = `{
+	"get": "odds", "errors": [], "results": 1, "paging": {"current": 1, "total": 1},
+	"response": [{
+		"fixture": {"id": 1208021},
+		"bookmakers": [
+			{"id": 6, "name": "Bwin", "bets": [
+				{"id": 1, "name": "Match Winner", "values": [
+					{"value": "Home", "odd": "2.00"}, {"value": "Draw", "odd": "3.40"}, {"value": "Away", "odd": "4.00"}
+				]},
+				{"id": 12, "name": "Home/Away", "values": [
+					{"value": "Home", "odd": "2.20"}, {"value": "Away", "odd": "1.75"}
+				]},
+				{"id": 99, "name": "Suspended", "values": [{"value": "Home", "odd": null}]}
+			]},
+			{"id": 8, "name": "Bet365", "bets": [
+				{"id": 1, "name": "Match Winner", "values": [
+					{"value": "Home", "odd": "2.10"}, {"value": "Draw", "odd": "3.30"}, {"value": "Away", "odd": "3.80"}
+				]},
+				{"id": 12, "name": "Home/Away", "values": [
+					{"value": "Home", "odd": "1.90"}, {"value": "Away", "odd": "2.25"}
+				]},
+				{"id": 5, "name": "Goals Over/Under", "values": [{"value": "Over 2.5", "odd": "1.01"}]}
+			]},
+			{"id": 11, "name": "1xBet", "bets": [
+				{"id": 5, "name": "Goals Over/Under", "values": [{"value": "Over 2.5", "odd": "3.00"}]},
+				{"id": 7, "name": "Correct Score", "values": [{"value": "Under 2.5", "odd": "3.00"}]}
+			]}
+		]
+	}]
+}`
+
+func response(t *testing.T, body string) *models.OddsResponse {
+	t.Helper()
+	var resp models.OddsResponse
+	assert.NoError(t, json.Unmarshal([]byte(body), &resp))
+	return &resp
+}
+
+func decimal(t *testing.T, s string) models.Decimal {
+	t.Helper()
+	d, err := models.ParseDecimal(s)
+	assert.NoError(t, err)
+	return d
+}
+
+func TestOddsMarkets(t *testing.T) {
+	resp := response(t, oddsAnalysisBody)
+	markets := odds.Markets(resp)
+	// The market without a price is left out.
+	assert.Len(t, markets, 7)
+	winner := markets[0]
+	assert.Equal(t, "Bwin", winner.Bookmaker)
+	assert.Equal(t, "Match Winner", winner.Bet)
+
+	assert.InDeltaSlice(t, []float64{0.5, 1 / 3.4, 0.25}, winner.ImpliedProbabilities(), 1e-12)
+	assert.InDelta(t, 1.0441176, winner.Overround(), 1e-6)
+	assert.InDelta(t, 0.0441176, winner.Margin(), 1e-6)
+
+	proportional := winner.FairProportional()
+	assert.InDelta(t, 1, proportional[0]+proportional[1]+proportional[2], 1e-12)
+	assert.InDelta(t, 0.5/1.0441176, proportional[0], 1e-6)
+
+	shin, z := winner.FairShin()
+	assert.InDelta(t, 1, shin[0]+shin[1]+shin[2], 1e-9)
+	assert.Greater(t, z, 0.0)
+	// Shin removes more of the margin from the longshot.
+	assert.Greater(t, shin[0], proportional[0])
+	assert.Less(t, shin[2], proportional[2])
+
+	noMargin := odds.Market{Selections: []odds.Selection{{Value: "Home", Odd: decimal(t, "2")}, {Value: "Away", Odd: decimal(t, "2")}}}
+	shin, z = noMargin.FairShin()
+	assert.Equal(t, []float64{0.5, 0.5}, shin)
+	assert.Equal(t, 0.0, z)
+}
+
+// overUnderBody prices two lines of the same Over/Under bet at two
+// bookmakers.
+const overUnderBody = `{
+	"get": "odds", "errors": [], "results": 1, "paging": {"current": 1, "total": 1},
+	"response": [{
+		"fixture": {"id": 1208021},
+		"bookmakers": [
+			{"id": 6, "name": "Bwin", "bets": [
+				{"id": 5, "name": "Goals Over/Under", "values": [
+					{"value": "Over 1.5", "odd": "1.25"}, {"value": "Under 1.5", "odd": "3.75"},
+					{"value": "Over 2.5", "odd": "1.80"}, {"value": "Under 2.5", "odd": "2.00"}
+				]}
+			]},
+			{"id": 8, "name": "Bet365", "bets": [
+				{"id": 5, "name": "Goals Over/Under", "values": [
+					{"value": "Over 2.5", "odd": "2.10"}, {"value": "Under 2.5", "odd": "1.70"},
+					{"value": "Over 1.5", "odd": "1.30"}, {"value": "Under 1.5", "odd": "3.40"}
+				]}
+			]}
+		]
+	}]
+}`
+
+// handicapBody prices two lines of an Asian Handicap bet, each with the home
+// and away sides of the handicap.
+const handicapBody = `{
+	"get": "odds", "errors": [], "results": 1, "paging": {"current": 1, "total": 1},
+	"response": [{
+		"fixture": {"id": 1208021},
+		"bookmakers": [
+			{"id": 8, "name": "Bet365", "bets": [
+				{"id": 4, "name": "Asian Handicap", "values": [
+					{"value": "Home -1", "odd": "2.10"}, {"value": "Away +1", "odd": "1.75"},
+					{"value": "Home +0", "odd": "1.40"}, {"value": "Away -0", "odd": "2.90"}
+				]}
+			]}
+		]
+	}]
+}`
+
+func TestOddsMarketsHandicapLines(t *testing.T) {
+	markets := odds.Markets(response(t, handicapBody))
+	assert.Len(t, markets, 2)
+	assert.Equal(t, "-1", markets[0].Line)
+	assert.Equal(t, []string{"Home -1", "Away +1"}, []string{markets[0].Selections[0].Value, markets[0].Selections[1].Value})
+	assert.InDelta(t, 1/2.1+1/1.75-1, markets[0].Margin(), 1e-12)
+	assert.Equal(t, "0", markets[1].Line)
+	assert.Len(t, markets[1].Selections, 2)
+}
+
+func TestOddsMarketsSplitLines(t *testing.T) {
+	markets := odds.Markets(response(t, overUnderBody))
+	assert.Len(t, markets, 4)
+
+	low, high := markets[0], markets[1]
+	assert.Equal(t, "1.5", low.Line)
+	assert.Equal(t, []string{"Over 1.5", "Under 1.5"}, []string{low.Selections[0].Value, low.Selections[1].Value})
+	assert.InDelta(t, 1/1.25+1/3.75-1, low.Margin(), 1e-12)
+	assert.Equal(t, "2.5", high.Line)
+	assert.InDelta(t, 1/1.8+1/2.0-1, high.Margin(), 1e-12)
+	fair := high.FairProportional()
+	assert.InDelta(t, 1, fair[0]+fair[1], 1e-12)
+	assert.InDelta(t, (1/1.8)/(1/1.8+1/2.0), fair[0], 1e-12)
+
+	// Bet365 lists its lines in the other order.
+	assert.Equal(t, "2.5", markets[2].Line)
+	assert.Equal(t, "1.5", markets[3].Line)
+
+	best := odds.Best(markets)
+	assert.Len(t, best, 2)
+	assert.Equal(t, "1.5", best[0].Line)
+	assert.Equal(t, "1.3", best[0].Prices[0].Odd.String())
+	assert.Equal(t, "3.75", best[0].Prices[1].Odd.String())
+	assert.False(t, best[0].IsArbitrage())
+	// Over 2.5 at Bet365 and Under 2.5 at Bwin cover every outcome of the
+	// line for less than the payout.
+	assert.Equal(t, "2.5", best[1].Line)
+	assert.Equal(t, []string{"Over 2.5", "Under 2.5"}, []string{best[1].Prices[0].Value, best[1].Prices[1].Value})
+	assert.True(t, best[1].IsArbitrage())
+	assert.InDelta(t, 1/(1/2.1+1/2.0)-1, best[1].Profit(), 1e-12)
+}
@@ -0,0 +1,458 @@
+package client
+
+import (
+	"context"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/0ffsideCompass/api-football-go-client/models"
+)
+
+// OddsMovementKind is the kind of change an OddsTracker reports.
+type OddsMovementKind string
+
+// Kinds of OddsMovement.
+const (
+	// OddsPriceUp is sent when the odd of a selection lengthens.
+	OddsPriceUp OddsMovementKind = "price_up"
+	// OddsPriceDown is sent when the odd of a selection shortens.
+	OddsPriceDown OddsMovementKind = "price_down"
+	// OddsSuspended is sent when a selection is suspended, or when betting
+	// on a whole fixture is stopped or blocked. In the latter case only the
+	// FixtureID of the Selection is set.
+	OddsSuspended OddsMovementKind = "suspended"
+	// OddsResumed is sent when a suspended selection or fixture reopens.
+	OddsResumed OddsMovementKind = "resumed"
+	// OddsMainLineChanged is sent when the main line of a handicap or
+	// over/under market moves. Only the FixtureID and BetID of the Selection
+	// are set.
+	OddsMainLineChanged OddsMovementKind = "main_line"
+	// OddsError is sent by Run when a sample fails. Sampling continues.
+	OddsError OddsMovementKind = "error"
+)
+
+// OddsSelection identifies a selection of an in-play market: "Over" at a
+// handicap of "2.5" in the "Over/Under Line" bet of a fixture, for example.
+type OddsSelection struct {
+	FixtureID int
+	BetID     int
+	// Value is the selection label, such as "Home", "Over" or "1:0".
+	Value    string
+	Handicap string
+}
+
+// OddsMovement is a change in the in-play odds of a fixture.
+type OddsMovement struct {
+	Kind      OddsMovementKind
+	Selection OddsSelection
+	// Time is when the API last updated the odds of the fixture.
+	Time time.Time
+	// Previous and Current are the odds before and after an OddsPriceUp or
+	// OddsPriceDown, and Delta is Current - Previous.
+	Previous models.Decimal
+	Current  models.Decimal
+	Delta    models.Decimal
+	// PreviousLine and Line are the handicaps of the main line before and
+	// after an OddsMainLineChanged.
+	PreviousLine string
+	Line         string
+	// Err is the error behind an OddsError.
+	Err error
+}
+
+// OddsPoint is a sample of a selection's odd. Odd is zero when the API sent
+// no price, as it does for some suspended selections.
+type OddsPoint struct {
+	Time      time.Time
+	Odd       models.Decimal
+	Suspended bool
+}
+
+// OddsSeries is the recorded history of a selection, oldest point first. A
+// point is recorded when the selection is first seen and whenever its odd or
+// suspension changes.
+type OddsSeries struct {
+	Selection OddsSelection
+	BetName   string
+	Points    []OddsPoint
+}
+
+// oddsMarket identifies an in-play market of a fixture.
+type oddsMarket struct {
+	fixtureID int
+	betID     int
+}
+
+// OddsTracker samples the in-play odds and reports their movements as
+// OddsMovements, keeping the history of every selection in memory.
+//
+// Selections and fixtures seen for the first time are recorded without
+// sending a movement.
+type OddsTracker struct {
+	client       *Client
+	interval     time.Duration
+	reserve      int
+	historyLimit int
+	fixtures     map[int]bool
+	bets         map[int]bool
+	events       chan OddsMovement
+
+	mu        sync.Mutex
+	running   bool
+	series    map[OddsSelection]*OddsSeries
+	mainLines map[oddsMarket]string
+	stopped   map[int]bool
+}
+
+// OddsTrackerOption configures an OddsTracker.
+type OddsTrackerOption func(*OddsTracker) error
+
+// WithOddsFixtures restricts the tracker to the given fixtures. By default
+// every fixture with in-play odds is tracked.
+func WithOddsFixtures(ids ...int) OddsTrackerOption {
+	return func(t *OddsTracker) error {
+		if len(ids) == 0 {
+			return errors.New("no fixtures given")
+		}
+		for _, id := range ids {
+			t.fixtures[id] = true
+		}
+		return nil
+	}
+}
+
+// WithOddsBets restricts the tracker to the given bets, such as 59 for
+// "Fulltime Result". By default every bet is tracked.
+func WithOddsBets(ids ...int) OddsTrackerOption {
+	return func(t *OddsTracker) error {
+		if len(ids) == 0 {
+			return errors.New("no bets given")
+		}
+		for _, id := range ids {
+			t.bets[id] = true
+		}
+		return nil
+	}
+}
+
+// WithOddsHistoryLimit keeps at most n points per selection, dropping the
+// oldest. By default the whole history is kept.
+func WithOddsHistoryLimit(n int) OddsTrackerOption {
+	return func(t *OddsTracker) error {
+		if n <= 0 {
+			return errors.New("history limit must be positive")
+		}
+		t.historyLimit = n
+		return nil
+	}
+}
+
+// WithOddsQuotaReserve pauses sampling until the daily quota resets (at
+// midnight UTC) once the API reports n or fewer requests left for the day.
+func WithOddsQuotaReserve(n int) OddsTrackerOption {
+	return func(t *OddsTracker) error {
+		if n <= 0 {
+			return errors.New("quota reserve must be positive")
+		}
+		t.reserve = n
+		return nil
+	}
+}
+
+// WithOddsBuffer sets the capacity of the Events channel. Run blocks when
+// the channel is full.
+func WithOddsBuffer(n int) OddsTrackerOption {
+	return func(t *OddsTracker) error {
+		if n < 0 {
+			return errors.New("negative buffer size")
+		}
+		t.events = make(chan OddsMovement, n)
+		return nil
+	}
+}
+
+// NewOddsTracker returns an OddsTracker sampling OddsLive every interval.
+// When a single fixture or bet is tracked the request is narrowed to it;
+// otherwise the in-play odds of every fixture are fetched and filtered, so
+// each sample costs a single request either way.
+func (c *Client) NewOddsTracker(interval time.Duration, opts ...OddsTrackerOption) (*OddsTracker, error) {
+	if interval <= 0 {
+		return nil, errors.New("odds tracker interval must be positive")
+	}
+	t := &OddsTracker{
+		client:    c,
+		interval:  interval,
+		fixtures:  make(map[int]bool),
+		bets:      make(map[int]bool),
+		events:    make(chan OddsMovement, defaultLiveBuffer),
+		series:    make(map[OddsSelection]*OddsSeries),
+		mainLines: make(map[oddsMarket]string),
+		stopped:   make(map[int]bool),
+	}
+	for _, opt := range opts {
+		if err := opt(t); err != nil {
+			return nil, fmt.Errorf("error creating odds tracker: %w", err)
+		}
+	}
+	return t, nil
+}
+
+// Events returns the channel Run sends movements on. It is closed when Run
+// returns.
+func (t *OddsTracker) Events() <-chan OddsMovement {
+	return t.events
+}
+
+// Run samples until ctx is done, sending the movements of each sample on the
+// Events channel, and returns ctx's error. Failed samples are reported as
+// OddsError movements. Like LiveWatcher.Run, it waits for the daily reset
+// when the quota runs out. Run may only be called once.
+func (t *OddsTracker) Run(ctx context.Context) error {
+	t.mu.Lock()
+	if t.running {
+		t.mu.Unlock()
+		return errors.New("odds tracker already running")
+	}
+	t.running = true
+	t.mu.Unlock()
+	defer close(t.events)
+
+	return pollLoop(ctx, t.client, t.interval, t.reserve, t.events, t.Sample, func(err error) OddsMovement {
+		return OddsMovement{Kind: OddsError, Err: err}
+	})
+}
+
+// Sample fetches the in-play odds once, records them, and returns the
+// movements since the previous sample. Sample must not be called
+// concurrently with itself or with Run.
+func (t *OddsTracker) Sample(ctx context.Context) ([]OddsMovement, error) {
+	params := map[string]any{}
+	if len(t.fixtures) == 1 {
+		for id := range t.fixtures {
+			params["fixture"] = id
+		}
+	}
+	if len(t.bets) == 1 {
+		for id := range t.bets {
+			params["bet"] = id
+		}
+	}
+	resp, err := t.client.OddsLiveContext(ctx, params)
+	if err != nil {
+		return nil, fmt.Errorf("error sampling live odds: %w", err)
+	}
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	now := time.Now()
+	var movements []OddsMovement
+	for _, odds := range resp.Response {
+		if len(t.fixtures) > 0 && !t.fixtures[odds.Fixture.ID] {
+			continue
+		}
+		at := odds.Update
+		if at.IsZero() {
+			at = now
+		}
+		movements = append(movements, t.record(odds, at)...)
+	}
+	return movements, nil
+}
+
+// record adds the odds of a fixture to the history and returns its
+// movements. t.mu must be held.
+func (t *OddsTracker) record(odds models.LiveOdds, at time.Time) []OddsMovement {
+	var movements []OddsMovement
+	id := odds.Fixture.ID
+
+	stopped := odds.Status.Stopped || odds.Status.Blocked
+	if was, seen := t.stopped[id]; seen && was != stopped {
+		kind := OddsResumed
+		if stopped {
+			kind = OddsSuspended
+		}
+		movements = append(movements, OddsMovement{Kind: kind, Selection: OddsSelection{FixtureID: id}, Time: at})
+	}
+	t.stopped[id] = stopped
+
+	for _, bet := range odds.Odds {
+		if len(t.bets) > 0 && !t.bets[bet.ID] {
+			continue
+		}
+		market := oddsMarket{fixtureID: id, betID: bet.ID}
+		if line, ok := mainLine(bet); ok {
+			if previous, seen := t.mainLines[market]; seen && previous != line {
+				movements = append(movements, OddsMovement{
+					Kind:         OddsMainLineChanged,
+					Selection:    OddsSelection{FixtureID: id, BetID: bet.ID},
+					Time:         at,
+					PreviousLine: previous,
+					Line:         line,
+				})
+			}
+			t.mainLines[market] = line
+		}
+
+		for _, value := range bet.Values {
+			// A suspended selection may come without a price; its
+			// suspension is still tracked.
+			odd, ok := value.Price()
+			if !ok && !value.Suspended {
+				continue
+			}
+			sel := OddsSelection{
+				FixtureID: id,
+				BetID:     bet.ID,
+				Value:     fmt.Sprint(value.Value),
+				Handicap:  value.Handicap,
+			}
+			point := OddsPoint{Time: at, Odd: odd, Suspended: value.Suspended}
+			series, seen := t.series[sel]
+			if !seen {
+				t.series[sel] = &OddsSeries{Selection: sel, BetName: bet.Name, Points: []OddsPoint{point}}
+				continue
+			}
+
+			last := series.Points[len(series.Points)-1]
+			if last.Suspended != point.Suspended {
+				kind := OddsResumed
+				if point.Suspended {
+					kind = OddsSuspended
+				}
+				movements = append(movements, OddsMovement{Kind: kind, Selection: sel, Time: at})
+			}
+			// Price moves compare against the last known price, skipping
+			// points without one.
+			if previous := series.lastPrice(); ok && !previous.IsZero() {
+				if cmp := point.Odd.Cmp(previous); cmp != 0 {
+					kind := OddsPriceUp
+					if cmp < 0 {
+						kind = OddsPriceDown
+					}
+					movements = append(movements, OddsMovement{
+						Kind:      kind,
+						Selection: sel,
+						Time:      at,
+						Previous:  previous,
+						Current:   point.Odd,
+						Delta:     point.Odd.Sub(previous),
+					})
+				}
+			}
+			if last.Suspended != point.Suspended || last.Odd != point.Odd {
+				series.Points = append(series.Points, point)
+				if t.historyLimit > 0 && len(series.Points) > t.historyLimit {
+					series.Points = series.Points[len(series.Points)-t.historyLimit:]
+				}
+			}
+		}
+	}
+	return movements
+}
+
+// lastPrice returns the latest odd of the series that has a price, or zero.
+func (s *OddsSeries) lastPrice() models.Decimal {
+	for i := len(s.Points) - 1; i >= 0; i-- {
+		if !s.Points[i].Odd.IsZero() {
+			return s.Points[i].Odd
+		}
+	}
+	return models.Decimal{}
+}
+
+// mainLine returns the handicap of the selections of bet marked as the main
+// line, if any.
+func mainLine(bet models.LiveBet) (string, bool) {
+	for _, value := range bet.Values {
+		if value.Main == true {
+			return value.Handicap, true
+		}
+	}
+	return "", false
+}
+
+// History returns the recorded points of a selection, oldest first, or nil
+// if the selection was never seen.
+func (t *OddsTracker) History(sel OddsSelection) []OddsPoint {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	series, ok := t.series[sel]
+	if !ok {
+		return nil
+	}
+	return append([]OddsPoint(nil), series.Points...)
+}
+
+// Series returns the history of every selection, ordered by fixture, bet,
+// value and handicap.
+func (t *OddsTracker) Series() []OddsSeries {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	all := make([]OddsSeries, 0, len(t.series))
+	for _, series := range t.series {
+		s := *series
+		s.Points = append([]OddsPoint(nil), series.Points...)
+		all = append(all, s)
+	}
+	sort.Slice(all, func(i, j int) bool {
+		a, b := all[i].Selection, all[j].Selection
+		if a.FixtureID != b.FixtureID {
+			return a.FixtureID < b.FixtureID
+		}
+		if a.BetID != b.BetID {
+			return a.BetID < b.BetID
+		}
+		if a.Value != b.Value {
+			return a.Value < b.Value
+		}
+		return a.Handicap < b.Handicap
+	})
+	return all
+}
+
+// WriteCSV writes the history of every selection to w as CSV, one row per
+// point, in the order of Series.
+func (t *OddsTracker) WriteCSV(w io.Writer) error {
+	cw := csv.NewWriter(w)
+	header := []string{"fixture", "bet", "bet_name", "value", "handicap", "time", "odd", "suspended"}
+	if err := cw.Write(header); err != nil {
+		return err
+	}
+	for _, series := range t.Series() {
+		sel := series.Selection
+		for _, point := range series.Points {
+			err := cw.Write([]string{
+				strconv.Itoa(sel.FixtureID),
+				strconv.Itoa(sel.BetID),
+				series.BetName,
+				sel.Value,
+				sel.Handicap,
+				point.Time.UTC().Format(time.RFC3339),
+				oddString(point.Odd),
+				strconv.FormatBool(point.Suspended),
+			})
+			if err != nil {
+				return err
+			}
+		}
+	}
+	cw.Flush()
+	return cw.Error()
+}
+
+// oddString formats an odd for WriteCSV, leaving a missing price empty.
+func oddString(odd models.Decimal) string {
+	if odd.IsZero() {
+		return ""
+	}
+	return odd.String()
+}
@@ -0,0 +1,231 @@
+package client_test
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	client "github.com/0ffsideCompass/api-football-go-client"
+	"github.com/0ffsideCompass/api-football-go-client/models"
+)
+
+// liveOdds renders the in-play odds of fixture 1 with a match winner market
+// and an over/under market whose main line is mainLine.
+func liveOdds(update string, stopped bool, home, draw string, homeSuspended bool, mainLine string) string {
+	return fmt.Sprintf(`{"errors": [], "results": 1, "response": [{
+		"fixture": {"id": 1, "status": {"long": "Second Half", "elapsed": 62}},
+		"status": {"stopped": %t, "blocked": false, "finished": false},
+		"update": %q,
+		"odds": [
+			{"id": 59, "name": "Fulltime Result", "values": [
+				{"value": "Home", "odd": %q, "handicap": null, "main": null, "suspended": %t},
+				{"value": "Draw", "odd": %q, "handicap": null, "main": null, "suspended": false}
+			]},
+			{"id": 36, "name": "Over/Under Line", "values": [
+				{"value": "Over", "odd": "1.9", "handicap": "2.5", "main": %t, "suspended": false},
+				{"value": "Over", "odd": "2.4", "handicap": "3.5", "main": %t, "suspended": false}
+			]}
+		]
+	}]}`, stopped, update, home, homeSuspended, draw, mainLine == "2.5", mainLine == "3.5")
+}
+
+func TestOddsTrackerMovements(t *testing.T) {
+	bodies := []string{
+		liveOdds("2024-05-01T19:00:00+00:00", false, "1.85", "3.40", false, "2.5"),
+		liveOdds("2024-05-01T19:00:05+00:00", false, "1.80", "3.55", false, "2.5"),
+		liveOdds("2024-05-01T19:00:10+00:00", true, "1.80", "3.55", true, "3.5"),
+		liveOdds("2024-05-01T19:00:15+00:00", false, "1.80", "3.55", false, "3.5"),
+	}
+	var n int
+	httpClient := &MockHTTPClient{Respond: respondOK(func(*http.Request) string {
+		n++
+		return bodies[n-1]
+	})}
+	apiClient, err := client.New("test-key", httpClient)
+	assert.NoError(t, err)
+	tracker, err := apiClient.NewOddsTracker(time.Second, client.WithOddsFixtures(1))
+	assert.NoError(t, err)
+	ctx := context.Background()
+
+	movements, err := tracker.Sample(ctx)
+	assert.NoError(t, err)
+	assert.Empty(t, movements, "the first sample only records")
+	assert.Equal(t, "https://v3.football.api-sports.io/odds/live?fixture=1", httpClient.URLs[0])
+
+	movements, err = tracker.Sample(ctx)
+	assert.NoError(t, err)
+	assert.Len(t, movements, 2)
+	home := client.OddsSelection{FixtureID: 1, BetID: 59, Value: "Home"}
+	assert.Equal(t, client.OddsPriceDown, movements[0].Kind)
+	assert.Equal(t, home, movements[0].Selection)
+	assert.Equal(t, "1.85", movements[0].Previous.String())
+	assert.Equal(t, "1.8", movements[0].Current.String())
+	assert.Equal(t, "-0.05", movements[0].Delta.String())
+	assert.Equal(t, client.OddsPriceUp, movements[1].Kind)
+	assert.Equal(t, "0.15", movements[1].Delta.String())
+
+	movements, err = tracker.Sample(ctx)
+	assert.NoError(t, err)
+	var kinds []client.OddsMovementKind
+	for _, m := range movements {
+		kinds = append(kinds, m.Kind)
+	}
+	assert.Equal(t, []client.OddsMovementKind{client.OddsSuspended, client.OddsSuspended, client.OddsMainLineChanged}, kinds)
+	assert.Equal(t, client.OddsSelection{FixtureID: 1}, movements[0].Selection)
+	assert.Equal(t, home, movements[1].Selection)
+	assert.Equal(t, "2.5", movements[2].PreviousLine)
+	assert.Equal(t, "3.5", movements[2].Line)
+
+	movements, err = tracker.Sample(ctx)
+	assert.NoError(t, err)
+	assert.Len(t, movements, 2)
+	assert.Equal(t, client.OddsResumed, movements[0].Kind)
+	assert.Equal(t, client.OddsResumed, movements[1].Kind)
+
+	history := tracker.History(home)
+	assert.Len(t, history, 4)
+	assert.Equal(t, "1.85", history[0].Odd.String())
+	assert.True(t, history[2].Suspended)
+	assert.Equal(t, time.Date(2024, 5, 1, 19, 0, 15, 0, time.UTC), history[3].Time.UTC())
+	assert.Nil(t, tracker.History(client.OddsSelection{FixtureID: 2}))
+
+	series := tracker.Series()
+	assert.Len(t, series, 4)
+	assert.Equal(t, client.OddsSelection{FixtureID: 1, BetID: 36, Value: "Over", Handicap: "2.5"}, series[0].Selection)
+	assert.Equal(t, "Over/Under Line", series[0].BetName)
+
+	var buf bytes.Buffer
+	assert.NoError(t, tracker.WriteCSV(&buf))
+	lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+	assert.Equal(t, "fixture,bet,bet_name,value,handicap,time,odd,suspended", lines[0])
+	assert.Equal(t, "1,36,Over/Under Line,Over,2.5,2024-05-01T19:00:00Z,1.9,false", lines[1])
+	assert.Len(t, lines, 1+1+1+4+2)
+}
+
+func TestOddsTrackerSuspendedWithoutPrice(t *testing.T) {
+	body := func(odd string, suspended bool) string {
+		return fmt.Sprintf(`{"errors": [], "results": 1, "response": [{
+			"fixture": {"id": 1}, "status": {"stopped": false, "blocked": false, "finished": false},
+			"update": "2024-05-01T19:00:00+00:00",
+			"odds": [{"id": 59, "name": "Fulltime Result", "values": [
+				{"value": "Home", "odd": %s, "handicap": null, "main": null, "suspended": %t}
+			]}]
+		}]}`, odd, suspended)
+	}
+	bodies := []string{body(`"2.00"`, false), body("null", true), body(`"2.10"`, false)}
+	var n int
+	httpClient := &MockHTTPClient{Respond: respondOK(func(*http.Request) string {
+		n++
+		return bodies[n-1]
+	})}
+	apiClient, err := client.New("test-key", httpClient)
+	assert.NoError(t, err)
+	tracker, err := apiClient.NewOddsTracker(time.Second)
+	assert.NoError(t, err)
+	ctx := context.Background()
+	home := client.OddsSelection{FixtureID: 1, BetID: 59, Value: "Home"}
+
+	_, err = tracker.Sample(ctx)
+	assert.NoError(t, err)
+	movements, err := tracker.Sample(ctx)
+	assert.NoError(t, err)
+	assert.Equal(t, []client.OddsMovement{{Kind: client.OddsSuspended, Selection: home, Time: movements[0].Time}}, movements)
+
+	// The price on reopening is compared with the last known one.
+	movements, err = tracker.Sample(ctx)
+	assert.NoError(t, err)
+	assert.Len(t, movements, 2)
+	assert.Equal(t, client.OddsResumed, movements[0].Kind)
+	assert.Equal(t, client.OddsPriceUp, movements[1].Kind)
+	assert.Equal(t, "2", movements[1].Previous.String())
+	assert.Equal(t, "0.1", movements[1].Delta.String())
+
+	history := tracker.History(home)
+	assert.Len(t, history, 3)
+	assert.True(t, history[1].Suspended)
+	assert.True(t, history[1].Odd.IsZero())
+
+	var buf bytes.Buffer
+	assert.NoError(t, tracker.WriteCSV(&buf))
+	assert.Contains(t, buf.String(), "\n1,59,Fulltime Result,Home,,2024-05-01T19:00:00Z,,true\n")
+}
+
+func TestOddsTrackerQuotaReserveMinuteHeaders(t *testing.T) {
+	// Only the per-minute headers: the daily quota is unknown, not spent.
+	header := http.Header{}
+	header.Set("X-RateLimit-Limit", "300")
+	header.Set("X-RateLimit-Remaining", "299")
+	body := liveOdds("2024-05-01T19:00:00+00:00", false, "1.85", "3.40", false, "2.5")
+	var calls atomic.Int32
+	httpClient := &MockHTTPClient{Respond: func(*http.Request) (*http.Response, error) {
+		calls.Add(1)
+		return mockResponse(http.StatusOK, header, body), nil
+	}}
+	apiClient, err := client.New("test-key", httpClient)
+	assert.NoError(t, err)
+	tracker, err := apiClient.NewOddsTracker(time.Millisecond, client.WithOddsQuotaReserve(10))
+	assert.NoError(t, err)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan error, 1)
+	go func() { done <- tracker.Run(ctx) }()
+	assert.Eventually(t, func() bool { return calls.Load() >= 3 }, time.Second, time.Millisecond,
+		"sampling goes on without daily headers")
+	cancel()
+	assert.ErrorIs(t, <-done, context.Canceled)
+}
+
+func TestOddsTrackerFilters(t *testing.T) {
+	body := liveOdds("2024-05-01T19:00:00+00:00", false, "1.85", "3.40", false, "2.5")
+	httpClient := &MockHTTPClient{Respond: respondOK(func(*http.Request) string { return body })}
+	apiClient, err := client.New("test-key", httpClient)
+	assert.NoError(t, err)
+	tracker, err := apiClient.NewOddsTracker(time.Second,
+		client.WithOddsFixtures(1, 2), client.WithOddsBets(59), client.WithOddsHistoryLimit(1))
+	assert.NoError(t, err)
+
+	_, err = tracker.Sample(context.Background())
+	assert.NoError(t, err)
+	assert.Equal(t, "https://v3.football.api-sports.io/odds/live?bet=59", httpClient.URLs[0])
+	assert.Len(t, tracker.Series(), 2)
+
+	_, err = apiClient.NewOddsTracker(0)
+	assert.EqualError(t, err, "odds tracker interval must be positive")
+	_, err = apiClient.NewOddsTracker(time.Second, client.WithOddsBets())
+	assert.EqualError(t, err, "error creating odds tracker: no bets given")
+	_, err = apiClient.NewOddsTracker(time.Second, client.WithOddsHistoryLimit(0))
+	assert.EqualError(t, err, "error creating odds tracker: history limit must be positive")
+}
+
+func TestOddsTrackerRun(t *testing.T) {
+	prices := []string{"2.00", "2.10"}
+	var n int
+	httpClient := &MockHTTPClient{Respond: respondOK(func(*http.Request) string {
+		price := prices[min(n, len(prices)-1)]
+		n++
+		return liveOdds("2024-05-01T19:00:00+00:00", false, price, "3.40", false, "2.5")
+	})}
+	apiClient, err := client.New("test-key", httpClient)
+	assert.NoError(t, err)
+	tracker, err := apiClient.NewOddsTracker(time.Millisecond)
+	assert.NoError(t, err)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan error, 1)
+	go func() { done <- tracker.Run(ctx) }()
+
+	movement := <-tracker.Events()
+	assert.Equal(t, client.OddsPriceUp, movement.Kind)
+	want, _ := models.ParseDecimal("2.1")
+	assert.Equal(t, want, movement.Current)
+
+	cancel()
+	assert.ErrorIs(t, <-done, context.Canceled)
+}
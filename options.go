@@ -0,0 +1,107 @@
+package client
+
+import (
+	"errors"
+	"net/http"
+	"strings"
+	"time"
+)
+
+// Option configures a Client created with NewClient.
+type Option func(*Client) error
+
+// NewClient creates a new Client for the API-Football service configured by
+// opts. Without options it sends requests to the direct API through
+// http.DefaultClient. It returns an error if the API key is missing or an
+// option is invalid.
+func NewClient(key string, opts ...Option) (*Client, error) {
+	if key == "" {
+		return nil, errors.New("missing key")
+	}
+	c := &Client{
+		key:    key,
+		Domain: domain,
+		client: http.DefaultClient,
+	}
+	for _, opt := range opts {
+		if err := opt(c); err != nil {
+			return nil, err
+		}
+	}
+	c.resolveProvider()
+	return c, nil
+}
+
+// WithHTTPClient sets the HTTP client used to execute requests.
+func WithHTTPClient(client HttpClient) Option {
+	return func(c *Client) error {
+		if client == nil {
+			return errors.New("missing http client")
+		}
+		c.client = client
+		return nil
+	}
+}
+
+// WithBaseURL sets the base URL endpoint paths are appended to, for example
+// the RapidAPI gateway's https://api-football-v1.p.rapidapi.com/v3/.
+func WithBaseURL(baseURL string) Option {
+	return func(c *Client) error {
+		if baseURL == "" {
+			return errors.New("missing domain")
+		}
+		// Endpoint paths are appended directly to the domain, so it must end with a slash.
+		if !strings.HasSuffix(baseURL, "/") {
+			baseURL += "/"
+		}
+		c.Domain = baseURL
+		return nil
+	}
+}
+
+// WithUserAgent sets the User-Agent header sent with every request.
+func WithUserAgent(userAgent string) Option {
+	return func(c *Client) error {
+		c.userAgent = userAgent
+		return nil
+	}
+}
+
+// WithTimeout bounds every request, including reading the response body,
+// to d. It applies on top of any deadline of the context passed to a
+// ...Context method, and works with any HttpClient.
+func WithTimeout(d time.Duration) Option {
+	return func(c *Client) error {
+		if d < 0 {
+			return errors.New("negative timeout")
+		}
+		c.timeout = d
+		return nil
+	}
+}
+
+// WithRateLimiter sets the client's Limiter.
+func WithRateLimiter(limiter *RateLimiter) Option {
+	return func(c *Client) error {
+		c.Limiter = limiter
+		return nil
+	}
+}
+
+// WithQuotaHook sets the client's QuotaHook.
+func WithQuotaHook(hook func(QuotaStatus)) Option {
+	return func(c *Client) error {
+		c.QuotaHook = hook
+		return nil
+	}
+}
+
+// WithStrictParams makes endpoint methods reject parameters the endpoint
+// does not document, such as a misspelled "leauge", instead of forwarding
+// them to the API. The error suggests the closest known name.
+func WithStrictParams() Option {
+	return func(c *Client) error {
+		c.strictParams = true
+		return nil
+	}
+}
@@ -0,0 +1,98 @@
+package client_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	client "github.com/0ffsideCompass/api-football-go-client"
+)
+
+func TestNewClient(t *testing.T) {
+	tests := []struct {
+		name          string
+		key           string
+		opts          []client.Option
+		expectedError error
+		expectedURL   string
+	}{
+		{
+			name:        "defaults to the direct API",
+			key:         "123",
+			expectedURL: "https://v3.football.api-sports.io/",
+		},
+		{
+			name:        "base URL gets a trailing slash",
+			key:         "123",
+			opts:        []client.Option{client.WithBaseURL("https://api-football-v1.p.rapidapi.com/v3")},
+			expectedURL: "https://api-football-v1.p.rapidapi.com/v3/",
+		},
+		{
+			name:          "missing key",
+			key:           "",
+			expectedError: errors.New("missing key"),
+		},
+		{
+			name:          "nil http client",
+			key:           "123",
+			opts:          []client.Option{client.WithHTTPClient(nil)},
+			expectedError: errors.New("missing http client"),
+		},
+		{
+			name:          "empty base URL",
+			key:           "123",
+			opts:          []client.Option{client.WithBaseURL("")},
+			expectedError: errors.New("missing domain"),
+		},
+		{
+			name:          "negative timeout",
+			key:           "123",
+			opts:          []client.Option{client.WithTimeout(-time.Second)},
+			expectedError: errors.New("negative timeout"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			apiClient, err := client.NewClient(tt.key, tt.opts...)
+			assert.Equal(t, tt.expectedError, err)
+			if tt.expectedError == nil {
+				assert.Equal(t, tt.expectedURL, apiClient.Domain)
+			} else {
+				assert.Nil(t, apiClient)
+			}
+		})
+	}
+}
+
+func TestNewClientOptionsApplyToRequests(t *testing.T) {
+	mockClient := newMockClient()
+	limiter := client.NewRateLimiter(0, 1)
+	apiClient, err := client.NewClient("test-key",
+		client.WithHTTPClient(mockClient),
+		client.WithBaseURL("https://example.com/v3"),
+		client.WithUserAgent("scheduler/1.0"),
+		client.WithRateLimiter(limiter),
+	)
+	assert.NoError(t, err)
+	assert.Same(t, limiter, apiClient.Limiter)
+
+	_, err = apiClient.Timezone()
+	assert.NoError(t, err)
+	assert.Equal(t, "https://example.com/v3/timezone", mockClient.LastRequest.URL.String())
+	assert.Equal(t, "scheduler/1.0", mockClient.LastRequest.Header.Get("User-Agent"))
+}
+
+func TestWithTimeout(t *testing.T) {
+	apiClient, err := client.NewClient("test-key",
+		client.WithHTTPClient(&MockHTTPClient{Respond: blockUntilDone}),
+		client.WithTimeout(10*time.Millisecond),
+	)
+	assert.NoError(t, err)
+
+	_, err = apiClient.Timezone()
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+}
@@ -0,0 +1,144 @@
+package client
+
+import (
+	"context"
+
+	"github.com/0ffsideCompass/api-football-go-client/models"
+)
+
+// Pager walks every page of a paginated endpoint (Players, PlayersProfiles,
+// Odds, OddsMapping), fetching each page only when the items of the
+// previous one have been consumed. Use it like a bufio.Scanner:
+//
+//	pager := cli.PlayersAll(ctx, map[string]any{"league": 39, "season": 2023})
+//	for pager.Next() {
+//		player := pager.Item()
+//		// ...
+//	}
+//	if err := pager.Err(); err != nil {
+//		// ...
+//	}
+//
+// Iteration stops at the first error, including cancellation of ctx. A
+// Pager is not safe for concurrent use.
+type Pager[T any] struct {
+	ctx    context.Context
+	fetch  func(ctx context.Context, page int) ([]T, models.Pagination, error)
+	page   int
+	paging models.Pagination
+	items  []T
+	index  int
+	item   T
+	err    error
+	done   bool
+}
+
+// paged is implemented by the responses of paginated endpoints, through
+// their models.Envelope.
+type paged[T any] interface {
+	Page() ([]T, models.Pagination)
+}
+
+// newPager returns a Pager starting at the page given in params, or page 1.
+// params is copied, so the caller's map is never modified.
+func newPager[R paged[T], T any](
+	ctx context.Context,
+	params map[string]any,
+	call func(ctx context.Context, params map[string]any) (R, error),
+) *Pager[T] {
+	first := 1
+	if p, ok := params["page"]; ok {
+		if n, err := asInt(p); err == nil && n > 0 {
+			first = n
+		}
+	}
+	return &Pager[T]{
+		ctx:  ctx,
+		page: first,
+		fetch: func(ctx context.Context, page int) ([]T, models.Pagination, error) {
+			pageParams := make(map[string]any, len(params)+1)
+			for k, v := range params {
+				pageParams[k] = v
+			}
+			pageParams["page"] = page
+			resp, err := call(ctx, pageParams)
+			if err != nil {
+				return nil, models.Pagination{}, err
+			}
+			items, paging := resp.Page()
+			return items, paging, nil
+		},
+	}
+}
+
+// Next advances to the next item, fetching the next page if needed. It
+// returns false when there are no more items or an error occurred.
+func (p *Pager[T]) Next() bool {
+	for {
+		if p.err != nil {
+			return false
+		}
+		if err := p.ctx.Err(); err != nil {
+			p.err = err
+			return false
+		}
+		if p.index < len(p.items) {
+			p.item = p.items[p.index]
+			p.index++
+			return true
+		}
+		if p.done {
+			return false
+		}
+
+		items, paging, err := p.fetch(p.ctx, p.page)
+		if err != nil {
+			p.err = err
+			return false
+		}
+		p.items, p.index, p.paging = items, 0, paging
+		// The API reports the last page in paging.total; an empty page also
+		// ends the walk so a bad total cannot loop forever.
+		if p.page >= paging.Total || len(items) == 0 {
+			p.done = true
+		}
+		p.page++
+	}
+}
+
+// Item returns the current item. It is only valid after a call to Next that
+// returned true.
+func (p *Pager[T]) Item() T {
+	return p.item
+}
+
+// Err returns the error that stopped the iteration, if any.
+func (p *Pager[T]) Err() error {
+	return p.err
+}
+
+// Paging returns the pagination of the most recently fetched page.
+func (p *Pager[T]) Paging() models.Pagination {
+	return p.paging
+}
+
+// PlayersAll returns a Pager over every page of Players for params.
+func (c *Client) PlayersAll(ctx context.Context, params map[string]any) *Pager[models.PlayerWithStats] {
+	return newPager[*models.PlayersResponse](ctx, params, c.PlayersContext)
+}
+
+// PlayersProfilesAll returns a Pager over every page of PlayersProfiles for
+// params.
+func (c *Client) PlayersProfilesAll(ctx context.Context, params map[string]any) *Pager[models.PlayerProfile] {
+	return newPager[*models.PlayersProfilesResponse](ctx, params, c.PlayersProfilesContext)
+}
+
+// OddsAll returns a Pager over every page of Odds for params.
+func (c *Client) OddsAll(ctx context.Context, params map[string]any) *Pager[models.FixtureOdds] {
+	return newPager[*models.OddsResponse](ctx, params, c.OddsContext)
+}
+
+// OddsMappingAll returns a Pager over every page of OddsMapping for params.
+func (c *Client) OddsMappingAll(ctx context.Context, params map[string]any) *Pager[models.OddsMapping] {
+	return newPager[*models.OddsMappingResponse](ctx, params, c.OddsMappingContext)
+}
@@ -0,0 +1,29 @@
+//go:build go1.23
+
+package client
+
+import "iter"
+
+// All returns an iterator over the remaining items for use with range. The
+// error that stopped the iteration, if any, is yielded last with a zero
+// item:
+//
+//	for player, err := range cli.PlayersAll(ctx, params).All() {
+//		if err != nil {
+//			return err
+//		}
+//		// ...
+//	}
+func (p *Pager[T]) All() iter.Seq2[T, error] {
+	return func(yield func(T, error) bool) {
+		for p.Next() {
+			if !yield(p.Item(), nil) {
+				return
+			}
+		}
+		if err := p.Err(); err != nil {
+			var zero T
+			yield(zero, err)
+		}
+	}
+}
@@ -0,0 +1,32 @@
+//go:build go1.23
+
+package client_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	client "github.com/0ffsideCompass/api-football-go-client"
+)
+
+func TestPagerRangeOverFunc(t *testing.T) {
+	httpClient := pagedPlayers(2, nil)
+	apiClient, err := client.New("test-key", httpClient)
+	assert.NoError(t, err)
+
+	var ids []int
+	for player, err := range apiClient.PlayersAll(context.Background(), nil).All() {
+		assert.NoError(t, err)
+		ids = append(ids, player.Player.ID)
+	}
+	assert.Equal(t, []int{11, 12, 21, 22}, ids)
+
+	// Breaking out early stops fetching.
+	httpClient.URLs = nil
+	for range apiClient.PlayersAll(context.Background(), nil).All() {
+		break
+	}
+	assert.Equal(t, []int{1}, requestedPages(httpClient))
+}
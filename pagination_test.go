@@ -0,0 +1,113 @@
+package client_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	client "github.com/0ffsideCompass/api-football-go-client"
+)
+
+// pagedPlayers serves totalPages pages of two players each, numbered by
+// page. onRequest, if set, is called with each requested page.
+func pagedPlayers(totalPages int, onRequest func(page int)) *MockHTTPClient {
+	return &MockHTTPClient{Respond: respondOK(func(req *http.Request) string {
+		page, _ := strconv.Atoi(req.URL.Query().Get("page"))
+		if onRequest != nil {
+			onRequest(page)
+		}
+		return fmt.Sprintf(`{"errors": [], "results": 2, "paging": {"current": %d, "total": %d}, "response": [
+			{"player": {"id": %d}}, {"player": {"id": %d}}
+		]}`, page, totalPages, page*10+1, page*10+2)
+	})}
+}
+
+// requestedPages returns the pages requested from httpClient.
+func requestedPages(httpClient *MockHTTPClient) []int {
+	var pages []int
+	for _, rawURL := range httpClient.URLs {
+		u, _ := url.Parse(rawURL)
+		page, _ := strconv.Atoi(u.Query().Get("page"))
+		pages = append(pages, page)
+	}
+	return pages
+}
+
+func TestPagerWalksEveryPage(t *testing.T) {
+	httpClient := pagedPlayers(3, nil)
+	apiClient, err := client.New("test-key", httpClient)
+	assert.NoError(t, err)
+
+	params := map[string]any{"league": 39, "season": 2023}
+	pager := apiClient.PlayersAll(context.Background(), params)
+
+	var ids []int
+	for pager.Next() {
+		ids = append(ids, pager.Item().Player.ID)
+	}
+	assert.NoError(t, pager.Err())
+	assert.Equal(t, []int{11, 12, 21, 22, 31, 32}, ids)
+	assert.Equal(t, []int{1, 2, 3}, requestedPages(httpClient))
+	assert.Equal(t, 3, pager.Paging().Current)
+	// The caller's params are left untouched.
+	assert.NotContains(t, params, "page")
+}
+
+func TestPagerStartsAtGivenPage(t *testing.T) {
+	httpClient := pagedPlayers(3, nil)
+	apiClient, err := client.New("test-key", httpClient)
+	assert.NoError(t, err)
+
+	pager := apiClient.PlayersAll(context.Background(), map[string]any{"league": 39, "season": 2023, "page": 2})
+	count := 0
+	for pager.Next() {
+		count++
+	}
+	assert.NoError(t, pager.Err())
+	assert.Equal(t, 4, count)
+	assert.Equal(t, []int{2, 3}, requestedPages(httpClient))
+}
+
+func TestPagerFetchesLazily(t *testing.T) {
+	httpClient := pagedPlayers(5, nil)
+	apiClient, err := client.New("test-key", httpClient)
+	assert.NoError(t, err)
+
+	pager := apiClient.PlayersAll(context.Background(), nil)
+	assert.True(t, pager.Next())
+	assert.True(t, pager.Next())
+	assert.Equal(t, []int{1}, requestedPages(httpClient))
+}
+
+func TestPagerStopsOnCancellation(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	httpClient := pagedPlayers(5, func(page int) {
+		if page == 2 {
+			cancel()
+		}
+	})
+	apiClient, err := client.New("test-key", httpClient)
+	assert.NoError(t, err)
+
+	pager := apiClient.PlayersAll(ctx, nil)
+	count := 0
+	for pager.Next() {
+		count++
+	}
+	assert.ErrorIs(t, pager.Err(), context.Canceled)
+	assert.Equal(t, 2, count)
+	assert.Equal(t, []int{1, 2}, requestedPages(httpClient))
+}
+
+func TestPagerStopsOnError(t *testing.T) {
+	apiClient := newTestClient(t, `{"errors": {"season": "The Season field must contain 4 characters."}, "response": []}`)
+
+	pager := apiClient.OddsAll(context.Background(), map[string]any{"season": 23})
+	assert.False(t, pager.Next())
+	assert.ErrorIs(t, pager.Err(), client.ErrInvalidParameter)
+}
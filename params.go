@@ -0,0 +1,757 @@
+package client
+
+import (
+	"time"
+
+	"github.com/0ffsideCompass/api-football-go-client/models"
+)
+
+// Params is implemented by the typed parameter structs. Encode returns the
+// same query parameters the map-based methods accept; zero-valued fields
+// are left out.
+type Params interface {
+	Encode() map[string]any
+}
+
+// Flag is a boolean query parameter that can also be left unset.
+type Flag string
+
+// The values of a Flag. The zero value leaves the parameter out.
+const (
+	FlagTrue  Flag = "true"
+	FlagFalse Flag = "false"
+)
+
+// paramMap collects the non-zero fields of a parameter struct.
+type paramMap map[string]any
+
+func (m paramMap) setInt(key string, v int) {
+	if v != 0 {
+		m[key] = v
+	}
+}
+
+func (m paramMap) setString(key, v string) {
+	if v != "" {
+		m[key] = v
+	}
+}
+
+func (m paramMap) setDate(key string, v time.Time) {
+	if !v.IsZero() {
+		m[key] = v.Format(dateFormat)
+	}
+}
+
+func (m paramMap) setIDs(key string, v []int) {
+	if len(v) > 0 {
+		m[key] = paramString(v)
+	}
+}
+
+func (m paramMap) setPair(key string, v [2]int) {
+	if v != [2]int{} {
+		m[key] = paramString(v[:])
+	}
+}
+
+func (m paramMap) setFlag(key string, v Flag) {
+	if v != "" {
+		m[key] = string(v)
+	}
+}
+
+func (m paramMap) setStatus(key string, v models.StatusFilter) {
+	if len(v) > 0 {
+		m[key] = v.String()
+	}
+}
+
+// CountriesParams are the parameters of Countries.
+type CountriesParams struct {
+	// The name of the country.
+	Name string
+	// The 2-letter alpha code of the country.
+	Code string
+	// A name to search for.
+	Search string
+}
+
+// Encode implements Params.
+func (p CountriesParams) Encode() map[string]any {
+	m := paramMap{}
+	m.setString("name", p.Name)
+	m.setString("code", p.Code)
+	m.setString("search", p.Search)
+	return m
+}
+
+// LeaguesParams are the parameters of Leagues.
+type LeaguesParams struct {
+	// The ID of the league.
+	ID int
+	// The name of the league.
+	Name string
+	// The name of the country.
+	Country string
+	// The 2-letter alpha code of the country.
+	Code string
+	// The season, as a 4-digit year.
+	Season int
+	// The ID of the team.
+	Team int
+	// The type of competition: "league" or "cup".
+	Type string
+	// Only the current season of each competition.
+	Current Flag
+	// A name to search for.
+	Search string
+	// The X last leagues or cups added.
+	Last int
+}
+
+// Encode implements Params.
+func (p LeaguesParams) Encode() map[string]any {
+	m := paramMap{}
+	m.setInt("id", p.ID)
+	m.setString("name", p.Name)
+	m.setString("country", p.Country)
+	m.setString("code", p.Code)
+	m.setInt("season", p.Season)
+	m.setInt("team", p.Team)
+	m.setString("type", p.Type)
+	m.setFlag("current", p.Current)
+	m.setString("search", p.Search)
+	m.setInt("last", p.Last)
+	return m
+}
+
+// TeamsParams are the parameters of Teams.
+type TeamsParams struct {
+	// The ID of the team.
+	ID int
+	// The name of the team.
+	Name string
+	// The ID of the league.
+	League int
+	// The season, as a 4-digit year.
+	Season int
+	// The name of the country.
+	Country string
+	// The 3-letter code of the team.
+	Code string
+	// The ID of the venue.
+	Venue int
+	// A name to search for.
+	Search string
+}
+
+// Encode implements Params.
+func (p TeamsParams) Encode() map[string]any {
+	m := paramMap{}
+	m.setInt("id", p.ID)
+	m.setString("name", p.Name)
+	m.setInt("league", p.League)
+	m.setInt("season", p.Season)
+	m.setString("country", p.Country)
+	m.setString("code", p.Code)
+	m.setInt("venue", p.Venue)
+	m.setString("search", p.Search)
+	return m
+}
+
+// TeamsStatisticsParams are the parameters of TeamsStatistics.
+type TeamsStatisticsParams struct {
+	// The ID of the league.
+	League int
+	// The season, as a 4-digit year.
+	Season int
+	// The ID of the team.
+	Team int
+	// The limit date of the statistics.
+	Date time.Time
+}
+
+// Encode implements Params.
+func (p TeamsStatisticsParams) Encode() map[string]any {
+	m := paramMap{}
+	m.setInt("league", p.League)
+	m.setInt("season", p.Season)
+	m.setInt("team", p.Team)
+	m.setDate("date", p.Date)
+	return m
+}
+
+// TeamsSeasonsParams are the parameters of TeamsSeasons.
+type TeamsSeasonsParams struct {
+	// The ID of the team.
+	Team int
+}
+
+// Encode implements Params.
+func (p TeamsSeasonsParams) Encode() map[string]any {
+	m := paramMap{}
+	m.setInt("team", p.Team)
+	return m
+}
+
+// VenuesParams are the parameters of Venues.
+type VenuesParams struct {
+	// The ID of the venue.
+	ID int
+	// The name of the venue.
+	Name string
+	// The city of the venue.
+	City string
+	// The country of the venue.
+	Country string
+	// A name to search for.
+	Search string
+}
+
+// Encode implements Params.
+func (p VenuesParams) Encode() map[string]any {
+	m := paramMap{}
+	m.setInt("id", p.ID)
+	m.setString("name", p.Name)
+	m.setString("city", p.City)
+	m.setString("country", p.Country)
+	m.setString("search", p.Search)
+	return m
+}
+
+// StandingsParams are the parameters of Standings.
+type StandingsParams struct {
+	// The ID of the league.
+	League int
+	// The season, as a 4-digit year.
+	Season int
+	// The ID of the team.
+	Team int
+}
+
+// Encode implements Params.
+func (p StandingsParams) Encode() map[string]any {
+	m := paramMap{}
+	m.setInt("league", p.League)
+	m.setInt("season", p.Season)
+	m.setInt("team", p.Team)
+	return m
+}
+
+// FixturesParams are the parameters of Fixture.
+type FixturesParams struct {
+	// The ID of the fixture.
+	ID int
+	// Up to 20 fixture IDs.
+	IDs []int
+	// "all", or league IDs as "id-id", for fixtures in progress.
+	Live string
+	// The date to filter on.
+	Date time.Time
+	// The ID of the league.
+	League int
+	// The season, as a 4-digit year.
+	Season int
+	// The ID of the team.
+	Team int
+	// The X last fixtures.
+	Last int
+	// The X next fixtures.
+	Next int
+	// The start of a date range.
+	From time.Time
+	// The end of a date range.
+	To time.Time
+	// The round of the fixtures.
+	Round string
+	// One or more fixture statuses.
+	Status models.StatusFilter
+	// The ID of the venue.
+	Venue int
+	// A timezone from the Timezone endpoint, e.g. Europe/London.
+	Timezone string
+}
+
+// Encode implements Params.
+func (p FixturesParams) Encode() map[string]any {
+	m := paramMap{}
+	m.setInt("id", p.ID)
+	m.setIDs("ids", p.IDs)
+	m.setString("live", p.Live)
+	m.setDate("date", p.Date)
+	m.setInt("league", p.League)
+	m.setInt("season", p.Season)
+	m.setInt("team", p.Team)
+	m.setInt("last", p.Last)
+	m.setInt("next", p.Next)
+	m.setDate("from", p.From)
+	m.setDate("to", p.To)
+	m.setString("round", p.Round)
+	m.setStatus("status", p.Status)
+	m.setInt("venue", p.Venue)
+	m.setString("timezone", p.Timezone)
+	return m
+}
+
+// FixturesRoundsParams are the parameters of FixturesRounds.
+type FixturesRoundsParams struct {
+	// The ID of the league.
+	League int
+	// The season, as a 4-digit year.
+	Season int
+	// Only the current round.
+	Current Flag
+	// Include the dates of each round.
+	Dates Flag
+	// A timezone from the Timezone endpoint, e.g. Europe/London.
+	Timezone string
+}
+
+// Encode implements Params.
+func (p FixturesRoundsParams) Encode() map[string]any {
+	m := paramMap{}
+	m.setInt("league", p.League)
+	m.setInt("season", p.Season)
+	m.setFlag("current", p.Current)
+	m.setFlag("dates", p.Dates)
+	m.setString("timezone", p.Timezone)
+	return m
+}
+
+// FixturesEventsParams are the parameters of FixturesEvents.
+type FixturesEventsParams struct {
+	// The ID of the fixture.
+	Fixture int
+	// The ID of the team.
+	Team int
+	// The ID of the player.
+	Player int
+	// The type of event, e.g. "Goal".
+	Type string
+}
+
+// Encode implements Params.
+func (p FixturesEventsParams) Encode() map[string]any {
+	m := paramMap{}
+	m.setInt("fixture", p.Fixture)
+	m.setInt("team", p.Team)
+	m.setInt("player", p.Player)
+	m.setString("type", p.Type)
+	return m
+}
+
+// FixturesLineupsParams are the parameters of FixturesLineups.
+type FixturesLineupsParams struct {
+	// The ID of the fixture.
+	Fixture int
+	// The ID of the team.
+	Team int
+	// The ID of the player.
+	Player int
+	// The type of lineup data, e.g. "startXI".
+	Type string
+}
+
+// Encode implements Params.
+func (p FixturesLineupsParams) Encode() map[string]any {
+	m := paramMap{}
+	m.setInt("fixture", p.Fixture)
+	m.setInt("team", p.Team)
+	m.setInt("player", p.Player)
+	m.setString("type", p.Type)
+	return m
+}
+
+// FixtureHeadToHeadParams are the parameters of FixtureHeadToHead.
+type FixtureHeadToHeadParams struct {
+	// The IDs of the two teams.
+	H2H [2]int
+	// The date to filter on.
+	Date time.Time
+	// The ID of the league.
+	League int
+	// The season, as a 4-digit year.
+	Season int
+	// The X last fixtures.
+	Last int
+	// The X next fixtures.
+	Next int
+	// The start of a date range.
+	From time.Time
+	// The end of a date range.
+	To time.Time
+	// One or more fixture statuses.
+	Status models.StatusFilter
+	// The ID of the venue.
+	Venue int
+	// A timezone from the Timezone endpoint, e.g. Europe/London.
+	Timezone string
+}
+
+// Encode implements Params.
+func (p FixtureHeadToHeadParams) Encode() map[string]any {
+	m := paramMap{}
+	m.setPair("h2h", p.H2H)
+	m.setDate("date", p.Date)
+	m.setInt("league", p.League)
+	m.setInt("season", p.Season)
+	m.setInt("last", p.Last)
+	m.setInt("next", p.Next)
+	m.setDate("from", p.From)
+	m.setDate("to", p.To)
+	m.setStatus("status", p.Status)
+	m.setInt("venue", p.Venue)
+	m.setString("timezone", p.Timezone)
+	return m
+}
+
+// FixtureStatisticsParams are the parameters of FixtureStatistics.
+type FixtureStatisticsParams struct {
+	// The ID of the fixture.
+	Fixture int
+	// The ID of the team.
+	Team int
+	// The type of statistic, e.g. "Total Shots".
+	Type string
+}
+
+// Encode implements Params.
+func (p FixtureStatisticsParams) Encode() map[string]any {
+	m := paramMap{}
+	m.setInt("fixture", p.Fixture)
+	m.setInt("team", p.Team)
+	m.setString("type", p.Type)
+	return m
+}
+
+// FixturesPlayersParams are the parameters of FixturesPlayer.
+type FixturesPlayersParams struct {
+	// The ID of the fixture.
+	Fixture int
+	// The ID of the team.
+	Team int
+}
+
+// Encode implements Params.
+func (p FixturesPlayersParams) Encode() map[string]any {
+	m := paramMap{}
+	m.setInt("fixture", p.Fixture)
+	m.setInt("team", p.Team)
+	return m
+}
+
+// InjuriesParams are the parameters of Injuries.
+type InjuriesParams struct {
+	// The ID of the league.
+	League int
+	// The season, as a 4-digit year.
+	Season int
+	// The ID of the fixture.
+	Fixture int
+	// The ID of the team.
+	Team int
+	// The ID of the player.
+	Player int
+	// The date of the fixture.
+	Date time.Time
+	// A timezone from the Timezone endpoint, e.g. Europe/London.
+	Timezone string
+}
+
+// Encode implements Params.
+func (p InjuriesParams) Encode() map[string]any {
+	m := paramMap{}
+	m.setInt("league", p.League)
+	m.setInt("season", p.Season)
+	m.setInt("fixture", p.Fixture)
+	m.setInt("team", p.Team)
+	m.setInt("player", p.Player)
+	m.setDate("date", p.Date)
+	m.setString("timezone", p.Timezone)
+	return m
+}
+
+// PredictionsParams are the parameters of Predictions.
+type PredictionsParams struct {
+	// The ID of the fixture.
+	Fixture int
+}
+
+// Encode implements Params.
+func (p PredictionsParams) Encode() map[string]any {
+	m := paramMap{}
+	m.setInt("fixture", p.Fixture)
+	return m
+}
+
+// CoachsParams are the parameters of Coachs.
+type CoachsParams struct {
+	// The ID of the coach.
+	ID int
+	// The ID of the team.
+	Team int
+	// A name to search for.
+	Search string
+}
+
+// Encode implements Params.
+func (p CoachsParams) Encode() map[string]any {
+	m := paramMap{}
+	m.setInt("id", p.ID)
+	m.setInt("team", p.Team)
+	m.setString("search", p.Search)
+	return m
+}
+
+// PlayersSeasonsParams are the parameters of PlayersSeasons.
+type PlayersSeasonsParams struct {
+	// The ID of the player.
+	Player int
+}
+
+// Encode implements Params.
+func (p PlayersSeasonsParams) Encode() map[string]any {
+	m := paramMap{}
+	m.setInt("player", p.Player)
+	return m
+}
+
+// PlayersParams are the parameters of Players.
+type PlayersParams struct {
+	// The ID of the player.
+	ID int
+	// The ID of the team.
+	Team int
+	// The ID of the league.
+	League int
+	// The season, as a 4-digit year.
+	Season int
+	// A name to search for.
+	Search string
+	// The page to fetch.
+	Page int
+}
+
+// Encode implements Params.
+func (p PlayersParams) Encode() map[string]any {
+	m := paramMap{}
+	m.setInt("id", p.ID)
+	m.setInt("team", p.Team)
+	m.setInt("league", p.League)
+	m.setInt("season", p.Season)
+	m.setString("search", p.Search)
+	m.setInt("page", p.Page)
+	return m
+}
+
+// PlayersSquadsParams are the parameters of PlayersSquads.
+type PlayersSquadsParams struct {
+	// The ID of the team.
+	Team int
+	// The ID of the player.
+	Player int
+}
+
+// Encode implements Params.
+func (p PlayersSquadsParams) Encode() map[string]any {
+	m := paramMap{}
+	m.setInt("team", p.Team)
+	m.setInt("player", p.Player)
+	return m
+}
+
+// PlayersTopParams are the parameters of PlayersTopScorers, PlayersTopAssists,
+// PlayersTopYellowCards and PlayersTopRedCards.
+type PlayersTopParams struct {
+	// The ID of the league.
+	League int
+	// The season, as a 4-digit year.
+	Season int
+}
+
+// Encode implements Params.
+func (p PlayersTopParams) Encode() map[string]any {
+	m := paramMap{}
+	m.setInt("league", p.League)
+	m.setInt("season", p.Season)
+	return m
+}
+
+// PlayersProfilesParams are the parameters of PlayersProfiles.
+type PlayersProfilesParams struct {
+	// The ID of the player.
+	Player int
+	// The last name of the player.
+	Search string
+	// The page to fetch.
+	Page int
+}
+
+// Encode implements Params.
+func (p PlayersProfilesParams) Encode() map[string]any {
+	m := paramMap{}
+	m.setInt("player", p.Player)
+	m.setString("search", p.Search)
+	m.setInt("page", p.Page)
+	return m
+}
+
+// PlayersTeamsParams are the parameters of PlayersTeams.
+type PlayersTeamsParams struct {
+	// The ID of the player.
+	Player int
+}
+
+// Encode implements Params.
+func (p PlayersTeamsParams) Encode() map[string]any {
+	m := paramMap{}
+	m.setInt("player", p.Player)
+	return m
+}
+
+// TransfersParams are the parameters of Transfers.
+type TransfersParams struct {
+	// The ID of the player.
+	Player int
+	// The ID of the team.
+	Team int
+}
+
+// Encode implements Params.
+func (p TransfersParams) Encode() map[string]any {
+	m := paramMap{}
+	m.setInt("player", p.Player)
+	m.setInt("team", p.Team)
+	return m
+}
+
+// TrophiesParams are the parameters of Trophies.
+type TrophiesParams struct {
+	// The ID of the player.
+	Player int
+	// The ID of the coach.
+	Coach int
+}
+
+// Encode implements Params.
+func (p TrophiesParams) Encode() map[string]any {
+	m := paramMap{}
+	m.setInt("player", p.Player)
+	m.setInt("coach", p.Coach)
+	return m
+}
+
+// SidelinedParams are the parameters of Sidelined.
+type SidelinedParams struct {
+	// The ID of the player.
+	Player int
+	// The ID of the coach.
+	Coach int
+}
+
+// Encode implements Params.
+func (p SidelinedParams) Encode() map[string]any {
+	m := paramMap{}
+	m.setInt("player", p.Player)
+	m.setInt("coach", p.Coach)
+	return m
+}
+
+// OddsParams are the parameters of Odds.
+type OddsParams struct {
+	// The ID of the fixture.
+	Fixture int
+	// The ID of the league.
+	League int
+	// The season, as a 4-digit year.
+	Season int
+	// The date of the fixtures.
+	Date time.Time
+	// A timezone from the Timezone endpoint, e.g. Europe/London.
+	Timezone string
+	// The page to fetch.
+	Page int
+	// The ID of the bookmaker.
+	Bookmaker int
+	// The ID of the bet.
+	Bet int
+}
+
+// Encode implements Params.
+func (p OddsParams) Encode() map[string]any {
+	m := paramMap{}
+	m.setInt("fixture", p.Fixture)
+	m.setInt("league", p.League)
+	m.setInt("season", p.Season)
+	m.setDate("date", p.Date)
+	m.setString("timezone", p.Timezone)
+	m.setInt("page", p.Page)
+	m.setInt("bookmaker", p.Bookmaker)
+	m.setInt("bet", p.Bet)
+	return m
+}
+
+// OddsMappingParams are the parameters of OddsMapping.
+type OddsMappingParams struct {
+	// The page to fetch.
+	Page int
+}
+
+// Encode implements Params.
+func (p OddsMappingParams) Encode() map[string]any {
+	m := paramMap{}
+	m.setInt("page", p.Page)
+	return m
+}
+
+// OddsBookmakersParams are the parameters of OddsBookmakers.
+type OddsBookmakersParams struct {
+	// The ID of the bookmaker.
+	ID int
+	// A name to search for.
+	Search string
+}
+
+// Encode implements Params.
+func (p OddsBookmakersParams) Encode() map[string]any {
+	m := paramMap{}
+	m.setInt("id", p.ID)
+	m.setString("search", p.Search)
+	return m
+}
+
+// OddsBetsParams are the parameters of OddsBets and OddsLiveBets.
+type OddsBetsParams struct {
+	// The ID of the bet.
+	ID int
+	// A name to search for.
+	Search string
+}
+
+// Encode implements Params.
+func (p OddsBetsParams) Encode() map[string]any {
+	m := paramMap{}
+	m.setInt("id", p.ID)
+	m.setString("search", p.Search)
+	return m
+}
+
+// OddsLiveParams are the parameters of OddsLive.
+type OddsLiveParams struct {
+	// The ID of the fixture.
+	Fixture int
+	// The ID of the league.
+	League int
+	// The ID of the bet.
+	Bet int
+}
+
+// Encode implements Params.
+func (p OddsLiveParams) Encode() map[string]any {
+	m := paramMap{}
+	m.setInt("fixture", p.Fixture)
+	m.setInt("league", p.League)
+	m.setInt("bet", p.Bet)
+	return m
+}
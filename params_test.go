@@ -0,0 +1,114 @@
+package client_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	client "github.com/0ffsideCompass/api-football-go-client"
+	"github.com/0ffsideCompass/api-football-go-client/models"
+)
+
+func TestParamsEncode(t *testing.T) {
+	tests := []struct {
+		name     string
+		params   client.Params
+		expected map[string]any
+	}{
+		{
+			name:     "zero values are left out",
+			params:   client.FixturesParams{},
+			expected: map[string]any{},
+		},
+		{
+			name: "fixtures",
+			params: client.FixturesParams{
+				League: 39,
+				Season: 2023,
+				From:   time.Date(2023, 8, 1, 18, 30, 0, 0, time.UTC),
+				To:     time.Date(2023, 8, 31, 0, 0, 0, 0, time.UTC),
+				Status: models.StatusFilter{models.StatusFinished, models.StatusFinishedAfterPens},
+			},
+			expected: map[string]any{
+				"league": 39,
+				"season": 2023,
+				"from":   "2023-08-01",
+				"to":     "2023-08-31",
+				"status": "FT-PEN",
+			},
+		},
+		{
+			name:     "fixture ids",
+			params:   client.FixturesParams{IDs: []int{1, 2, 3}},
+			expected: map[string]any{"ids": "1-2-3"},
+		},
+		{
+			name:     "head to head",
+			params:   client.FixtureHeadToHeadParams{H2H: [2]int{33, 34}, Last: 5},
+			expected: map[string]any{"h2h": "33-34", "last": 5},
+		},
+		{
+			name:     "flags",
+			params:   client.FixturesRoundsParams{League: 39, Season: 2023, Current: client.FlagTrue, Dates: client.FlagFalse},
+			expected: map[string]any{"league": 39, "season": 2023, "current": "true", "dates": "false"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, tt.params.Encode())
+		})
+	}
+}
+
+func TestParamsMethodsBuildSameURLs(t *testing.T) {
+	tests := []struct {
+		name        string
+		call        func(c *client.Client) error
+		expectedURL string
+	}{
+		{
+			name: "FixtureWith",
+			call: func(c *client.Client) error {
+				_, err := c.FixtureWith(context.Background(), client.FixturesParams{
+					League:   39,
+					Season:   2023,
+					Date:     time.Date(2023, 8, 12, 0, 0, 0, 0, time.UTC),
+					Timezone: "Europe/London",
+				})
+				return err
+			},
+			expectedURL: "https://v3.football.api-sports.io/fixtures?date=2023-08-12&league=39&season=2023&timezone=Europe%2FLondon",
+		},
+		{
+			name: "LeaguesWith",
+			call: func(c *client.Client) error {
+				_, err := c.LeaguesWith(context.Background(), client.LeaguesParams{Country: "England", Current: client.FlagTrue})
+				return err
+			},
+			expectedURL: "https://v3.football.api-sports.io/leagues?country=England&current=true",
+		},
+		{
+			name: "PlayersTopScorersWith",
+			call: func(c *client.Client) error {
+				_, err := c.PlayersTopScorersWith(context.Background(), client.PlayersTopParams{League: 39, Season: 2023})
+				return err
+			},
+			expectedURL: "https://v3.football.api-sports.io/players/topscorers?league=39&season=2023",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockClient := newMockClient()
+			apiClient, err := client.New("test-api-key", mockClient)
+			assert.NoError(t, err)
+
+			assert.NoError(t, tt.call(apiClient))
+			assert.NotNil(t, mockClient.LastRequest)
+			assert.Equal(t, tt.expectedURL, mockClient.LastRequest.URL.String())
+		})
+	}
+}
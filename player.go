@@ -1,6 +1,7 @@
 package client
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 
@@ -27,18 +28,22 @@ const (
 func (c *Client) PlayersSeasons(
 	params map[string]any,
 ) (*models.PlayersSeasonsResponse, error) {
-	// Validate the parameters
-	if _, hasPlayer := params["player"]; !hasPlayer {
-		return nil, fmt.Errorf("player must exist and be an integer")
-	}
+	return c.PlayersSeasonsContext(context.Background(), params)
+}
 
-	_, ok := params["player"].(int)
-	if !ok {
-		return nil, fmt.Errorf("player must exist and be an integer")
+// PlayersSeasonsContext is like PlayersSeasons but with a context.
+func (c *Client) PlayersSeasonsContext(
+	ctx context.Context,
+	params map[string]any,
+) (*models.PlayersSeasonsResponse, error) {
+	// Validate the parameters
+	if err := c.validateParams(playersSeasonsEndpoint, params); err != nil {
+		return nil, err
 	}
 
 	endpointURL := fmt.Sprintf("%s%s", c.Domain, playersSeasonsEndpoint)
 	body, err := c.get(
+		ctx,
 		c.buildURL(
 			endpointURL,
 			params,
@@ -60,6 +65,11 @@ func (c *Client) PlayersSeasons(
 	return &resp, nil
 }
 
+// PlayersSeasonsWith is like PlayersSeasonsContext but takes typed parameters.
+func (c *Client) PlayersSeasonsWith(ctx context.Context, params PlayersSeasonsParams) (*models.PlayersSeasonsResponse, error) {
+	return c.PlayersSeasonsContext(ctx, params.Encode())
+}
+
 // Players returns the players data
 /*
 	- id (Type: integer)
@@ -77,8 +87,22 @@ func (c *Client) PlayersSeasons(
 func (c *Client) Players(
 	params map[string]any,
 ) (*models.PlayersResponse, error) {
+	return c.PlayersContext(context.Background(), params)
+}
+
+// PlayersContext is like Players but with a context.
+func (c *Client) PlayersContext(
+	ctx context.Context,
+	params map[string]any,
+) (*models.PlayersResponse, error) {
+	// Validate the parameters
+	if err := c.validateParams(playersEndpoint, params); err != nil {
+		return nil, err
+	}
+
 	endpointURL := fmt.Sprintf("%s%s", c.Domain, playersEndpoint)
 	body, err := c.get(
+		ctx,
 		c.buildURL(
 			endpointURL,
 			params,
@@ -100,6 +124,11 @@ func (c *Client) Players(
 	return &resp, nil
 }
 
+// PlayersWith is like PlayersContext but takes typed parameters.
+func (c *Client) PlayersWith(ctx context.Context, params PlayersParams) (*models.PlayersResponse, error) {
+	return c.PlayersContext(ctx, params.Encode())
+}
+
 // PlayersSquads returns the players data for a given team
 /*
 	- team (Type: integer) (Required)
@@ -111,21 +140,22 @@ func (c *Client) Players(
 func (c *Client) PlayersSquads(
 	params map[string]any,
 ) (*models.PlayersSquadsResponse, error) {
-	// Team parameter is required
-	_, ok := params["team"].(int)
-	if !ok {
-		return nil, fmt.Errorf("team must exist and be an integer")
-	}
+	return c.PlayersSquadsContext(context.Background(), params)
+}
 
-	// Player parameter is optional
-	if val, ok := params["player"]; ok {
-		if _, ok := val.(int); !ok {
-			return nil, fmt.Errorf("player must be an integer if provided")
-		}
+// PlayersSquadsContext is like PlayersSquads but with a context.
+func (c *Client) PlayersSquadsContext(
+	ctx context.Context,
+	params map[string]any,
+) (*models.PlayersSquadsResponse, error) {
+	// Validate the parameters
+	if err := c.validateParams(playersSquadsEndpoint, params); err != nil {
+		return nil, err
 	}
 
 	endpointURL := fmt.Sprintf("%s%s", c.Domain, playersSquadsEndpoint)
 	body, err := c.get(
+		ctx,
 		c.buildURL(
 			endpointURL,
 			params,
@@ -147,6 +177,11 @@ func (c *Client) PlayersSquads(
 	return &resp, nil
 }
 
+// PlayersSquadsWith is like PlayersSquadsContext but takes typed parameters.
+func (c *Client) PlayersSquadsWith(ctx context.Context, params PlayersSquadsParams) (*models.PlayersSquadsResponse, error) {
+	return c.PlayersSquadsContext(ctx, params.Encode())
+}
+
 // PlayersTopScorers returns the top scorers for a given league and season
 /*
 	- league (Type: integer)(Required)
@@ -157,8 +192,22 @@ func (c *Client) PlayersSquads(
 func (c *Client) PlayersTopScorers(
 	params map[string]any,
 ) (*models.PlayersTopResponse, error) {
+	return c.PlayersTopScorersContext(context.Background(), params)
+}
+
+// PlayersTopScorersContext is like PlayersTopScorers but with a context.
+func (c *Client) PlayersTopScorersContext(
+	ctx context.Context,
+	params map[string]any,
+) (*models.PlayersTopResponse, error) {
+	// Validate the parameters
+	if err := c.validateParams(playersTopScorersEndpoint, params); err != nil {
+		return nil, err
+	}
+
 	endpointURL := fmt.Sprintf("%s%s", c.Domain, playersTopScorersEndpoint)
 	body, err := c.get(
+		ctx,
 		c.buildURL(
 			endpointURL,
 			params,
@@ -180,6 +229,11 @@ func (c *Client) PlayersTopScorers(
 	return &resp, nil
 }
 
+// PlayersTopScorersWith is like PlayersTopScorersContext but takes typed parameters.
+func (c *Client) PlayersTopScorersWith(ctx context.Context, params PlayersTopParams) (*models.PlayersTopResponse, error) {
+	return c.PlayersTopScorersContext(ctx, params.Encode())
+}
+
 // PlayersTopAssists returns the top assists for a given league and season
 /*
 	- league (Type: integer)(Required)
@@ -190,8 +244,22 @@ func (c *Client) PlayersTopScorers(
 func (c *Client) PlayersTopAssists(
 	params map[string]any,
 ) (*models.PlayersTopResponse, error) {
+	return c.PlayersTopAssistsContext(context.Background(), params)
+}
+
+// PlayersTopAssistsContext is like PlayersTopAssists but with a context.
+func (c *Client) PlayersTopAssistsContext(
+	ctx context.Context,
+	params map[string]any,
+) (*models.PlayersTopResponse, error) {
+	// Validate the parameters
+	if err := c.validateParams(playersTopAssistsEndpoint, params); err != nil {
+		return nil, err
+	}
+
 	endpointURL := fmt.Sprintf("%s%s", c.Domain, playersTopAssistsEndpoint)
 	body, err := c.get(
+		ctx,
 		c.buildURL(
 			endpointURL,
 			params,
@@ -213,6 +281,11 @@ func (c *Client) PlayersTopAssists(
 	return &resp, nil
 }
 
+// PlayersTopAssistsWith is like PlayersTopAssistsContext but takes typed parameters.
+func (c *Client) PlayersTopAssistsWith(ctx context.Context, params PlayersTopParams) (*models.PlayersTopResponse, error) {
+	return c.PlayersTopAssistsContext(ctx, params.Encode())
+}
+
 // PlayersTopYellowCards returns the top yellow cards for a given league and season
 /*
 	- league (Type: integer)(Required)
@@ -223,8 +296,22 @@ func (c *Client) PlayersTopAssists(
 func (c *Client) PlayersTopYellowCards(
 	params map[string]any,
 ) (*models.PlayersTopResponse, error) {
+	return c.PlayersTopYellowCardsContext(context.Background(), params)
+}
+
+// PlayersTopYellowCardsContext is like PlayersTopYellowCards but with a context.
+func (c *Client) PlayersTopYellowCardsContext(
+	ctx context.Context,
+	params map[string]any,
+) (*models.PlayersTopResponse, error) {
+	// Validate the parameters
+	if err := c.validateParams(playersTopYellowCardsEndpoint, params); err != nil {
+		return nil, err
+	}
+
 	endpointURL := fmt.Sprintf("%s%s", c.Domain, playersTopYellowCardsEndpoint)
 	body, err := c.get(
+		ctx,
 		c.buildURL(
 			endpointURL,
 			params,
@@ -246,6 +333,11 @@ func (c *Client) PlayersTopYellowCards(
 	return &resp, nil
 }
 
+// PlayersTopYellowCardsWith is like PlayersTopYellowCardsContext but takes typed parameters.
+func (c *Client) PlayersTopYellowCardsWith(ctx context.Context, params PlayersTopParams) (*models.PlayersTopResponse, error) {
+	return c.PlayersTopYellowCardsContext(ctx, params.Encode())
+}
+
 // PlayersTopRedCards returns the top red cards for a given league and season
 /*
 	- league (Type: integer)(Required)
@@ -256,8 +348,22 @@ func (c *Client) PlayersTopYellowCards(
 func (c *Client) PlayersTopRedCards(
 	params map[string]any,
 ) (*models.PlayersTopResponse, error) {
+	return c.PlayersTopRedCardsContext(context.Background(), params)
+}
+
+// PlayersTopRedCardsContext is like PlayersTopRedCards but with a context.
+func (c *Client) PlayersTopRedCardsContext(
+	ctx context.Context,
+	params map[string]any,
+) (*models.PlayersTopResponse, error) {
+	// Validate the parameters
+	if err := c.validateParams(playersTopRedCardsEndpoint, params); err != nil {
+		return nil, err
+	}
+
 	endpointURL := fmt.Sprintf("%s%s", c.Domain, playersTopRedCardsEndpoint)
 	body, err := c.get(
+		ctx,
 		c.buildURL(
 			endpointURL,
 			params,
@@ -279,6 +385,11 @@ func (c *Client) PlayersTopRedCards(
 	return &resp, nil
 }
 
+// PlayersTopRedCardsWith is like PlayersTopRedCardsContext but takes typed parameters.
+func (c *Client) PlayersTopRedCardsWith(ctx context.Context, params PlayersTopParams) (*models.PlayersTopResponse, error) {
+	return c.PlayersTopRedCardsContext(ctx, params.Encode())
+}
+
 // PlayersProfiles returns players' profile information.
 /*
 	- player (Type: integer)
@@ -291,8 +402,22 @@ func (c *Client) PlayersTopRedCards(
 func (c *Client) PlayersProfiles(
 	params map[string]any,
 ) (*models.PlayersProfilesResponse, error) {
+	return c.PlayersProfilesContext(context.Background(), params)
+}
+
+// PlayersProfilesContext is like PlayersProfiles but with a context.
+func (c *Client) PlayersProfilesContext(
+	ctx context.Context,
+	params map[string]any,
+) (*models.PlayersProfilesResponse, error) {
+	// Validate the parameters
+	if err := c.validateParams(playersProfilesEndpoint, params); err != nil {
+		return nil, err
+	}
+
 	endpointURL := fmt.Sprintf("%s%s", c.Domain, playersProfilesEndpoint)
 	body, err := c.get(
+		ctx,
 		c.buildURL(
 			endpointURL,
 			params,
@@ -314,6 +439,11 @@ func (c *Client) PlayersProfiles(
 	return &resp, nil
 }
 
+// PlayersProfilesWith is like PlayersProfilesContext but takes typed parameters.
+func (c *Client) PlayersProfilesWith(ctx context.Context, params PlayersProfilesParams) (*models.PlayersProfilesResponse, error) {
+	return c.PlayersProfilesContext(ctx, params.Encode())
+}
+
 // PlayersTeams returns the list of teams and seasons in which a player played.
 /*
 	- player (Type: integer) (Required)
@@ -321,14 +451,23 @@ func (c *Client) PlayersProfiles(
 */
 func (c *Client) PlayersTeams(
 	params map[string]any,
+) (*models.PlayersTeamsResponse, error) {
+	return c.PlayersTeamsContext(context.Background(), params)
+}
+
+// PlayersTeamsContext is like PlayersTeams but with a context.
+func (c *Client) PlayersTeamsContext(
+	ctx context.Context,
+	params map[string]any,
 ) (*models.PlayersTeamsResponse, error) {
 	// Validate the parameters
-	if err := requireIntParams(params, "player"); err != nil {
+	if err := c.validateParams(playersTeamsEndpoint, params); err != nil {
 		return nil, err
 	}
 
 	endpointURL := fmt.Sprintf("%s%s", c.Domain, playersTeamsEndpoint)
 	body, err := c.get(
+		ctx,
 		c.buildURL(
 			endpointURL,
 			params,
@@ -349,3 +488,8 @@ func (c *Client) PlayersTeams(
 
 	return &resp, nil
 }
+
+// PlayersTeamsWith is like PlayersTeamsContext but takes typed parameters.
+func (c *Client) PlayersTeamsWith(ctx context.Context, params PlayersTeamsParams) (*models.PlayersTeamsResponse, error) {
+	return c.PlayersTeamsContext(ctx, params.Encode())
+}
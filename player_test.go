@@ -38,7 +38,7 @@ func TestPlayersSeasons(t *testing.T) {
 			responseBody:  ``,
 			statusCode:    http.StatusOK,
 			expectError:   true,
-			errorContains: "player must exist and be an integer",
+			errorContains: "'player' is required",
 		},
 		{
 			name:          "players seasons request with non-integer player param",
@@ -46,7 +46,7 @@ func TestPlayersSeasons(t *testing.T) {
 			responseBody:  ``,
 			statusCode:    http.StatusOK,
 			expectError:   true,
-			errorContains: "player must exist and be an integer",
+			errorContains: "'player' must be an integer",
 		},
 		{
 			name:          "players seasons request with API error",
@@ -186,7 +186,7 @@ func TestPlayersSquads(t *testing.T) {
 			responseBody:  ``,
 			statusCode:    http.StatusOK,
 			expectError:   true,
-			errorContains: "team must exist and be an integer",
+			errorContains: "'team' is required",
 		},
 		{
 			name:   "players squads request without player param",
@@ -203,7 +203,7 @@ func TestPlayersSquads(t *testing.T) {
 			responseBody:  ``,
 			statusCode:    http.StatusOK,
 			expectError:   true,
-			errorContains: "team must exist and be an integer",
+			errorContains: "'team' must be an integer",
 		},
 	}
 
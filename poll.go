@@ -0,0 +1,74 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"time"
+)
+
+// pollLoop calls poll every interval until ctx is done, sending the events
+// it returns on out, and returns ctx's error. A failed poll is reported on
+// out as errorEvent(err). When the daily budget of the client's Limiter is
+// exhausted, or the API reports reserve or fewer requests left for the day,
+// pollLoop waits for the daily reset instead. A reserve of zero disables the
+// latter.
+func pollLoop[E any](
+	ctx context.Context,
+	c *Client,
+	interval time.Duration,
+	reserve int,
+	out chan<- E,
+	poll func(ctx context.Context) ([]E, error),
+	errorEvent func(err error) E,
+) error {
+	for {
+		wait := interval
+		if resetAt, ok := c.quotaReserved(reserve, time.Now()); ok {
+			wait = time.Until(resetAt)
+		} else {
+			events, err := poll(ctx)
+			if ctxErr := ctx.Err(); ctxErr != nil {
+				return ctxErr
+			}
+			if err != nil {
+				var budget *BudgetExhaustedError
+				if errors.As(err, &budget) {
+					wait = time.Until(budget.ResetAt)
+				}
+				events = append(events, errorEvent(err))
+			}
+			for _, ev := range events {
+				select {
+				case out <- ev:
+				case <-ctx.Done():
+					return ctx.Err()
+				}
+			}
+		}
+
+		timer := time.NewTimer(wait)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return ctx.Err()
+		case <-timer.C:
+		}
+	}
+}
+
+// quotaReserved reports whether the API last reported reserve or fewer
+// requests left for the day, and when the daily quota resets (midnight
+// UTC). Without daily headers, as when only the per-minute ones were sent,
+// nothing is reserved.
+func (c *Client) quotaReserved(reserve int, now time.Time) (time.Time, bool) {
+	if reserve <= 0 {
+		return time.Time{}, false
+	}
+	q := c.Quota()
+	if q.UpdatedAt.IsZero() || q.DailyLimit <= 0 || q.DailyRemaining > reserve {
+		return time.Time{}, false
+	}
+	y, m, d := q.UpdatedAt.UTC().Date()
+	resetAt := time.Date(y, m, d+1, 0, 0, 0, 0, time.UTC)
+	return resetAt, now.Before(resetAt)
+}
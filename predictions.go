@@ -1,6 +1,7 @@
 package client
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 
@@ -20,14 +21,23 @@ const (
 */
 func (c *Client) Predictions(
 	params map[string]any,
+) (*models.PredictionsResponse, error) {
+	return c.PredictionsContext(context.Background(), params)
+}
+
+// PredictionsContext is like Predictions but with a context.
+func (c *Client) PredictionsContext(
+	ctx context.Context,
+	params map[string]any,
 ) (*models.PredictionsResponse, error) {
 	// Validate the parameters
-	if err := requireIntParams(params, "fixture"); err != nil {
+	if err := c.validateParams(predictionsEndpoint, params); err != nil {
 		return nil, err
 	}
 
 	endpointURL := fmt.Sprintf("%s%s", c.Domain, predictionsEndpoint)
 	body, err := c.get(
+		ctx,
 		c.buildURL(
 			endpointURL,
 			params,
@@ -48,3 +58,8 @@ func (c *Client) Predictions(
 
 	return &resp, nil
 }
+
+// PredictionsWith is like PredictionsContext but takes typed parameters.
+func (c *Client) PredictionsWith(ctx context.Context, params PredictionsParams) (*models.PredictionsResponse, error) {
+	return c.PredictionsContext(ctx, params.Encode())
+}
@@ -0,0 +1,96 @@
+package client
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+// Provider identifies the gateway a Client talks to, which determines how
+// requests are authenticated.
+type Provider string
+
+// The supported providers.
+const (
+	// Direct is the api-sports.io API, authenticated with the
+	// x-apisports-key header.
+	Direct Provider = "api-sports"
+	// RapidAPI is the RapidAPI gateway, authenticated with the
+	// X-RapidAPI-Key and X-RapidAPI-Host headers.
+	RapidAPI Provider = "rapidapi"
+)
+
+// rapidAPIDomainSuffix is the host suffix of every RapidAPI listing.
+const rapidAPIDomainSuffix = ".rapidapi.com"
+
+// String returns the string representation of Provider.
+func (p Provider) String() string {
+	return string(p)
+}
+
+// WithProvider selects the authentication scheme. Without it, the provider
+// is detected from the base URL: hosts under rapidapi.com use RapidAPI, all
+// others Direct.
+func WithProvider(p Provider) Option {
+	return func(c *Client) error {
+		if p != Direct && p != RapidAPI {
+			return fmt.Errorf("unknown provider %q", p)
+		}
+		c.provider = p
+		return nil
+	}
+}
+
+// WithRapidAPIHost sets the X-RapidAPI-Host header sent in RapidAPI mode.
+// It defaults to the host of the base URL when that is a RapidAPI host, and
+// to api-football-v1.p.rapidapi.com otherwise.
+func WithRapidAPIHost(host string) Option {
+	return func(c *Client) error {
+		if host == "" {
+			return fmt.Errorf("missing rapidapi host")
+		}
+		c.rapidAPIHost = host
+		return nil
+	}
+}
+
+// Provider returns the provider the client authenticates against.
+func (c *Client) Provider() Provider {
+	return c.provider
+}
+
+// resolveProvider fills in the provider and RapidAPI host that were not set
+// explicitly, based on the base URL.
+func (c *Client) resolveProvider() {
+	var host string
+	if u, err := url.Parse(c.Domain); err == nil {
+		host = u.Hostname()
+	}
+	isRapidAPIHost := strings.HasSuffix(host, rapidAPIDomainSuffix)
+
+	if c.provider == "" {
+		c.provider = Direct
+		if isRapidAPIHost {
+			c.provider = RapidAPI
+		}
+	}
+	if c.provider == RapidAPI && c.rapidAPIHost == "" {
+		c.rapidAPIHost = hostVal
+		if isRapidAPIHost {
+			c.rapidAPIHost = host
+		}
+	}
+}
+
+// setAuthHeaders adds the authentication headers of the client's provider,
+// and only those, so the key is never sent to a gateway that is not in use.
+func (c *Client) setAuthHeaders(h http.Header) {
+	switch c.provider {
+	case RapidAPI:
+		h.Set(authKey, c.key)
+		h.Set(hostKey, c.rapidAPIHost)
+	default:
+		h.Set(apiSportsKey, c.key)
+	}
+}
@@ -0,0 +1,95 @@
+package client
+
+import (
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+)
+
+// Rate-limit headers sent by API-Football. The x-ratelimit-requests-* pair
+// tracks the daily plan quota; X-RateLimit-* tracks the per-minute limit.
+const (
+	dailyLimitHeader      = "x-ratelimit-requests-limit"
+	dailyRemainingHeader  = "x-ratelimit-requests-remaining"
+	minuteLimitHeader     = "X-RateLimit-Limit"
+	minuteRemainingHeader = "X-RateLimit-Remaining"
+)
+
+// QuotaStatus is a snapshot of the request quota reported by the API's
+// rate-limit headers. Counters the API has not reported yet are zero; use
+// UpdatedAt to tell a fresh snapshot from one that was never filled in.
+type QuotaStatus struct {
+	// DailyLimit is the number of requests the plan allows per day.
+	DailyLimit int
+	// DailyRemaining is the number of requests left for the current day.
+	DailyRemaining int
+	// MinuteLimit is the number of requests allowed per minute.
+	MinuteLimit int
+	// MinuteRemaining is the number of requests left in the current minute.
+	MinuteRemaining int
+	// UpdatedAt is when the last response carrying rate-limit headers was
+	// received. It is the zero time until then.
+	UpdatedAt time.Time
+}
+
+// quotaTracker keeps the latest QuotaStatus. It is safe for concurrent use.
+type quotaTracker struct {
+	mu     sync.RWMutex
+	status QuotaStatus
+}
+
+// snapshot returns the current status.
+func (q *quotaTracker) snapshot() QuotaStatus {
+	q.mu.RLock()
+	defer q.mu.RUnlock()
+	return q.status
+}
+
+// update applies the rate-limit headers found in h. It reports the new
+// status and whether any header was present; counters missing from h keep
+// their previous values.
+func (q *quotaTracker) update(h http.Header, now time.Time) (QuotaStatus, bool) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	found := false
+	for header, field := range map[string]*int{
+		dailyLimitHeader:      &q.status.DailyLimit,
+		dailyRemainingHeader:  &q.status.DailyRemaining,
+		minuteLimitHeader:     &q.status.MinuteLimit,
+		minuteRemainingHeader: &q.status.MinuteRemaining,
+	} {
+		n, err := strconv.Atoi(h.Get(header))
+		if err != nil {
+			continue
+		}
+		*field = n
+		found = true
+	}
+	if found {
+		q.status.UpdatedAt = now
+	}
+	return q.status, found
+}
+
+// Quota returns the request quota reported by the most recent response that
+// carried rate-limit headers. It is safe to call concurrently with requests.
+func (c *Client) Quota() QuotaStatus {
+	return c.quota.snapshot()
+}
+
+// recordQuota updates the quota snapshot from a response and passes it on to
+// Limiter and QuotaHook, if set.
+func (c *Client) recordQuota(res *http.Response) {
+	status, ok := c.quota.update(res.Header, time.Now())
+	if !ok {
+		return
+	}
+	if c.Limiter != nil {
+		c.Limiter.observe(status)
+	}
+	if c.QuotaHook != nil {
+		c.QuotaHook(status)
+	}
+}
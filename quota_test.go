@@ -0,0 +1,107 @@
+package client_test
+
+import (
+	"net/http"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	client "github.com/0ffsideCompass/api-football-go-client"
+)
+
+func TestQuotaFromHeaders(t *testing.T) {
+	header := http.Header{}
+	header.Set("x-ratelimit-requests-limit", "7500")
+	header.Set("x-ratelimit-requests-remaining", "7499")
+	header.Set("X-RateLimit-Limit", "300")
+	header.Set("X-RateLimit-Remaining", "299")
+	httpClient := &MockHTTPClient{Respond: respondWith(http.StatusOK, header, emptyBody)}
+
+	apiClient, err := client.New("test-key", httpClient)
+	assert.NoError(t, err)
+	assert.True(t, apiClient.Quota().UpdatedAt.IsZero())
+
+	var hooked []client.QuotaStatus
+	apiClient.QuotaHook = func(q client.QuotaStatus) { hooked = append(hooked, q) }
+
+	_, err = apiClient.Timezone()
+	assert.NoError(t, err)
+
+	quota := apiClient.Quota()
+	assert.Equal(t, 7500, quota.DailyLimit)
+	assert.Equal(t, 7499, quota.DailyRemaining)
+	assert.Equal(t, 300, quota.MinuteLimit)
+	assert.Equal(t, 299, quota.MinuteRemaining)
+	assert.False(t, quota.UpdatedAt.IsZero())
+	assert.Equal(t, []client.QuotaStatus{quota}, hooked)
+}
+
+func TestQuotaPartialAndMissingHeaders(t *testing.T) {
+	header := http.Header{}
+	header.Set("x-ratelimit-requests-limit", "100")
+	header.Set("x-ratelimit-requests-remaining", "42")
+	httpClient := &MockHTTPClient{Respond: func(*http.Request) (*http.Response, error) {
+		return mockResponse(http.StatusOK, header, emptyBody), nil
+	}}
+
+	apiClient, err := client.New("test-key", httpClient)
+	assert.NoError(t, err)
+	hookCalls := 0
+	apiClient.QuotaHook = func(client.QuotaStatus) { hookCalls++ }
+
+	_, err = apiClient.Timezone()
+	assert.NoError(t, err)
+
+	// A response without rate-limit headers leaves the snapshot untouched
+	// and does not call the hook.
+	header = http.Header{}
+	_, err = apiClient.Timezone()
+	assert.NoError(t, err)
+
+	// A per-minute-only update keeps the daily counters.
+	header.Set("X-RateLimit-Remaining", "9")
+	_, err = apiClient.Timezone()
+	assert.NoError(t, err)
+
+	quota := apiClient.Quota()
+	assert.Equal(t, 100, quota.DailyLimit)
+	assert.Equal(t, 42, quota.DailyRemaining)
+	assert.Equal(t, 9, quota.MinuteRemaining)
+	assert.Equal(t, 2, hookCalls)
+}
+
+func TestQuotaRecordedOnErrorResponses(t *testing.T) {
+	header := http.Header{}
+	header.Set("X-RateLimit-Remaining", "0")
+	httpClient := &MockHTTPClient{Respond: respondWith(http.StatusTooManyRequests, header, emptyBody)}
+
+	apiClient, err := client.New("test-key", httpClient)
+	assert.NoError(t, err)
+
+	_, err = apiClient.Timezone()
+	assert.Error(t, err)
+	assert.Equal(t, 0, apiClient.Quota().MinuteRemaining)
+	assert.False(t, apiClient.Quota().UpdatedAt.IsZero())
+}
+
+func TestQuotaConcurrentAccess(t *testing.T) {
+	header := http.Header{}
+	header.Set("x-ratelimit-requests-remaining", "10")
+	httpClient := &MockHTTPClient{Respond: respondWith(http.StatusOK, header, emptyBody)}
+
+	apiClient, err := client.New("test-key", httpClient)
+	assert.NoError(t, err)
+
+	var wg sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			_, _ = apiClient.Timezone()
+			_ = apiClient.Quota()
+		}()
+	}
+	wg.Wait()
+	assert.Equal(t, 10, apiClient.Quota().DailyRemaining)
+}
@@ -0,0 +1,168 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+)
+
+// BudgetExhaustedError is returned, without sending the request, once the
+// daily request budget is used up. It matches ErrQuotaExceeded with
+// errors.Is.
+type BudgetExhaustedError struct {
+	// Budget is the daily number of requests that has been used up.
+	Budget int
+	// ResetAt is when the budget is replenished (the next midnight UTC,
+	// which is when API-Football resets daily quotas).
+	ResetAt time.Time
+}
+
+func (e *BudgetExhaustedError) Error() string {
+	return fmt.Sprintf("daily request budget of %d exhausted until %s", e.Budget, e.ResetAt.Format(time.RFC3339))
+}
+
+// Is reports whether target is ErrQuotaExceeded.
+func (e *BudgetExhaustedError) Is(target error) bool {
+	return target == ErrQuotaExceeded
+}
+
+// RateLimiter throttles the requests of a Client to the per-minute limit of
+// an API-Football plan and enforces a daily request budget. It is a token
+// bucket holding one minute's worth of requests that refills continuously.
+// A RateLimiter is safe for concurrent use and may be shared by several
+// clients using the same key.
+type RateLimiter struct {
+	mu sync.Mutex
+
+	perMinute int
+	learn     bool
+	tokens    float64
+	last      time.Time
+
+	dailyBudget int
+	dailyUsed   int
+	day         time.Time
+	// serverExhausted is set when the API itself reports no daily requests
+	// left, which also covers quota spent by other processes.
+	serverExhausted bool
+	serverLimit     int
+}
+
+// NewRateLimiter returns a RateLimiter allowing perMinute requests per
+// minute and dailyBudget requests per day (UTC).
+//
+// If perMinute is zero or negative, the limit is learned from the
+// X-RateLimit-Limit header of the first response, and requests are not
+// throttled until then. If dailyBudget is zero or negative, only the daily
+// quota reported by the API's headers is enforced.
+func NewRateLimiter(perMinute, dailyBudget int) *RateLimiter {
+	if perMinute < 0 {
+		perMinute = 0
+	}
+	if dailyBudget < 0 {
+		dailyBudget = 0
+	}
+	return &RateLimiter{
+		perMinute:   perMinute,
+		learn:       perMinute == 0,
+		tokens:      float64(perMinute),
+		dailyBudget: dailyBudget,
+	}
+}
+
+// Wait blocks until a request may be sent and takes a slot for it. It
+// returns ctx's error if ctx is done first, and a *BudgetExhaustedError
+// straight away if the daily budget is used up.
+func (l *RateLimiter) Wait(ctx context.Context) error {
+	for {
+		delay, err := l.reserve(time.Now())
+		if err != nil || delay == 0 {
+			return err
+		}
+
+		timer := time.NewTimer(delay)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return ctx.Err()
+		case <-timer.C:
+		}
+	}
+}
+
+// reserve takes a slot if one is free. Otherwise it returns how long to wait
+// before trying again.
+func (l *RateLimiter) reserve(now time.Time) (time.Duration, error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	l.rollDay(now)
+	if l.serverExhausted || (l.dailyBudget > 0 && l.dailyUsed >= l.dailyBudget) {
+		budget := l.dailyBudget
+		if l.serverExhausted {
+			budget = l.serverLimit
+		}
+		return 0, &BudgetExhaustedError{Budget: budget, ResetAt: l.day.AddDate(0, 0, 1)}
+	}
+
+	if l.perMinute > 0 {
+		l.refill(now)
+		if l.tokens < 1 {
+			perToken := time.Minute / time.Duration(l.perMinute)
+			return time.Duration((1 - l.tokens) * float64(perToken)), nil
+		}
+		l.tokens--
+	}
+	l.dailyUsed++
+	return 0, nil
+}
+
+// refill adds the tokens accrued since the last refill.
+func (l *RateLimiter) refill(now time.Time) {
+	if now.Before(l.last) {
+		return
+	}
+	if !l.last.IsZero() {
+		elapsed := now.Sub(l.last).Minutes()
+		l.tokens += elapsed * float64(l.perMinute)
+		if l.tokens > float64(l.perMinute) {
+			l.tokens = float64(l.perMinute)
+		}
+	}
+	l.last = now
+}
+
+// rollDay resets the daily counters when a new UTC day starts.
+func (l *RateLimiter) rollDay(now time.Time) {
+	y, m, d := now.UTC().Date()
+	today := time.Date(y, m, d, 0, 0, 0, 0, time.UTC)
+	if !today.Equal(l.day) {
+		l.day = today
+		l.dailyUsed = 0
+		l.serverExhausted = false
+	}
+}
+
+// observe aligns the limiter with the quota reported by the API.
+func (l *RateLimiter) observe(q QuotaStatus) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	l.rollDay(q.UpdatedAt)
+	if l.learn && q.MinuteLimit > 0 && q.MinuteLimit != l.perMinute {
+		l.perMinute = q.MinuteLimit
+		l.tokens = float64(q.MinuteLimit)
+		l.last = q.UpdatedAt
+	}
+	if l.perMinute > 0 && q.MinuteLimit > 0 {
+		l.refill(q.UpdatedAt)
+		if remaining := float64(q.MinuteRemaining); remaining < l.tokens {
+			l.tokens = remaining
+		}
+	}
+	if q.DailyLimit > 0 && q.DailyRemaining <= 0 {
+		l.serverExhausted = true
+		l.serverLimit = q.DailyLimit
+	}
+}
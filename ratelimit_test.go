@@ -0,0 +1,91 @@
+package client_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	client "github.com/0ffsideCompass/api-football-go-client"
+)
+
+func TestRateLimiterBlocksWhenBucketIsEmpty(t *testing.T) {
+	// 1200 per minute refills one slot every 50ms.
+	limiter := client.NewRateLimiter(1200, 0)
+	for i := 0; i < 1200; i++ {
+		assert.NoError(t, limiter.Wait(context.Background()))
+	}
+
+	start := time.Now()
+	assert.NoError(t, limiter.Wait(context.Background()))
+	assert.GreaterOrEqual(t, time.Since(start), 30*time.Millisecond)
+}
+
+func TestRateLimiterHonorsContext(t *testing.T) {
+	limiter := client.NewRateLimiter(1, 0)
+	assert.NoError(t, limiter.Wait(context.Background()))
+
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+	assert.ErrorIs(t, limiter.Wait(ctx), context.DeadlineExceeded)
+}
+
+func TestRateLimiterDailyBudget(t *testing.T) {
+	httpClient := &MockHTTPClient{Respond: respondWith(http.StatusOK, nil, emptyBody)}
+	apiClient, err := client.New("test-key", httpClient)
+	assert.NoError(t, err)
+	apiClient.Limiter = client.NewRateLimiter(0, 2)
+
+	for i := 0; i < 2; i++ {
+		_, err = apiClient.Timezone()
+		assert.NoError(t, err)
+	}
+
+	_, err = apiClient.Timezone()
+	assert.ErrorIs(t, err, client.ErrQuotaExceeded)
+	var budgetErr *client.BudgetExhaustedError
+	assert.True(t, errors.As(err, &budgetErr))
+	assert.Equal(t, 2, budgetErr.Budget)
+	assert.True(t, budgetErr.ResetAt.After(time.Now()))
+}
+
+func TestRateLimiterLearnsFromHeaders(t *testing.T) {
+	header := http.Header{}
+	header.Set("X-RateLimit-Limit", "10")
+	header.Set("X-RateLimit-Remaining", "0")
+	httpClient := &MockHTTPClient{Respond: respondWith(http.StatusOK, header, emptyBody)}
+	apiClient, err := client.New("test-key", httpClient)
+	assert.NoError(t, err)
+	apiClient.Limiter = client.NewRateLimiter(0, 0)
+
+	// The first request goes through and teaches the limiter that the
+	// minute's quota is spent, so the next one has to wait.
+	_, err = apiClient.Timezone()
+	assert.NoError(t, err)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+	_, err = apiClient.TimezoneContext(ctx)
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+}
+
+func TestRateLimiterStopsWhenAPIReportsDailyQuotaSpent(t *testing.T) {
+	header := http.Header{}
+	header.Set("x-ratelimit-requests-limit", "100")
+	header.Set("x-ratelimit-requests-remaining", "0")
+	httpClient := &MockHTTPClient{Respond: respondWith(http.StatusOK, header, emptyBody)}
+	apiClient, err := client.New("test-key", httpClient)
+	assert.NoError(t, err)
+	apiClient.Limiter = client.NewRateLimiter(0, 0)
+
+	_, err = apiClient.Timezone()
+	assert.NoError(t, err)
+
+	_, err = apiClient.Timezone()
+	var budgetErr *client.BudgetExhaustedError
+	assert.ErrorAs(t, err, &budgetErr)
+	assert.Equal(t, 100, budgetErr.Budget)
+}
@@ -0,0 +1,169 @@
+// Package ratings fits team rating models to past results and forecasts
+// fixtures with them, as an alternative to the black-box /predictions
+// endpoint.
+//
+// Two models are provided. Elo rates teams from the results of their
+// matches, with home advantage and goal-margin scaling, and gives 1X2
+// probabilities. Poisson fits attack and defence strengths by maximum
+// likelihood, optionally with the Dixon-Coles low-score correction and time
+// decay, and gives full scoreline probabilities, from which 1X2, over/under
+// and both-teams-to-score probabilities follow:
+//
+//	resp, err := cli.Fixture(map[string]any{"league": 39, "season": 2023})
+//	// ...
+//	results := table.FromResponse(resp, table.DefaultRules).Results()
+//	model := &ratings.Poisson{DixonColes: true}
+//	if err := model.Fit(results); err != nil {
+//		// ...
+//	}
+//	m, err := model.ScoreMatrix(33, 34)
+//	over, _ := m.OverUnder(2.5)
+//
+// Both models are plain structs that marshal to and from JSON, so a fitted
+// model can be saved and reloaded, and both implement simulate.Model (Poisson
+// also implements simulate.ScoreModel) to drive season simulations.
+package ratings
+
+import (
+	"math"
+	"sort"
+
+	"github.com/0ffsideCompass/api-football-go-client/models"
+	"github.com/0ffsideCompass/api-football-go-client/simulate"
+	"github.com/0ffsideCompass/api-football-go-client/table"
+)
+
+// defaultDrawRate is the draw probability of two evenly matched teams before
+// an Elo model has seen any draws.
+const defaultDrawRate = 0.26
+
+// Elo is an Elo rating model. Its configuration fields are used as they
+// are: start from NewElo for the usual values. The ratings are updated by
+// Update and Fit, and the whole model marshals to JSON.
+type Elo struct {
+	// K is the update factor: how many points a single result moves.
+	K float64 `json:"k"`
+	// HomeAdvantage is the number of points added to the home team's
+	// rating when computing its expected result.
+	HomeAdvantage float64 `json:"homeAdvantage"`
+	// Initial is the rating of a team not seen yet.
+	Initial float64 `json:"initial"`
+	// MarginScaling scales updates by the goal margin, as the World Football
+	// Elo Ratings do: by 1.5 for a two-goal margin and (11+N)/8 for a margin
+	// of N ≥ 3.
+	MarginScaling bool `json:"marginScaling"`
+
+	// Ratings holds the rating of each team, keyed by team ID.
+	Ratings map[int]float64 `json:"ratings"`
+	// Matches and Draws count the results seen, to estimate the draw rate.
+	Matches int `json:"matches"`
+	Draws   int `json:"draws"`
+}
+
+// NewElo returns an Elo model with no ratings, a K of 20, a home advantage
+// of 65 points, an initial rating of 1500 and goal-margin scaling.
+func NewElo() *Elo {
+	return &Elo{
+		K:             20,
+		HomeAdvantage: 65,
+		Initial:       1500,
+		MarginScaling: true,
+		Ratings:       make(map[int]float64),
+	}
+}
+
+// Rating returns the rating of a team, or Initial if it has none yet.
+func (e *Elo) Rating(teamID int) float64 {
+	if r, ok := e.Ratings[teamID]; ok {
+		return r
+	}
+	return e.Initial
+}
+
+// Expected returns the expected result of the home team, between 0 and 1,
+// with a draw counting as half a win.
+func (e *Elo) Expected(home, away int) float64 {
+	diff := e.Rating(home) + e.HomeAdvantage - e.Rating(away)
+	return 1 / (1 + math.Pow(10, -diff/400))
+}
+
+// Update updates the ratings of the teams of a result.
+func (e *Elo) Update(r table.Result) {
+	if e.Ratings == nil {
+		e.Ratings = make(map[int]float64)
+	}
+	expected := e.Expected(r.Home.ID, r.Away.ID)
+	score := 0.5
+	switch {
+	case r.HomeGoals > r.AwayGoals:
+		score = 1
+	case r.HomeGoals < r.AwayGoals:
+		score = 0
+	default:
+		e.Draws++
+	}
+	delta := e.K * (score - expected)
+	if e.MarginScaling {
+		delta *= marginMultiplier(r.HomeGoals - r.AwayGoals)
+	}
+	e.Ratings[r.Home.ID] = e.Rating(r.Home.ID) + delta
+	e.Ratings[r.Away.ID] = e.Rating(r.Away.ID) - delta
+	e.Matches++
+}
+
+// marginMultiplier scales an update by the goal margin.
+func marginMultiplier(margin int) float64 {
+	if margin < 0 {
+		margin = -margin
+	}
+	switch {
+	case margin <= 1:
+		return 1
+	case margin == 2:
+		return 1.5
+	default:
+		return float64(11+margin) / 8
+	}
+}
+
+// Fit updates the ratings with results, oldest first. It can be called
+// again with newer results to carry on from the current ratings.
+func (e *Elo) Fit(results []table.Result) {
+	sorted := append([]table.Result(nil), results...)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return sorted[i].Date.Before(sorted[j].Date)
+	})
+	for _, r := range sorted {
+		e.Update(r)
+	}
+}
+
+// DrawRate returns the share of draws among the results seen.
+func (e *Elo) DrawRate() float64 {
+	if e.Matches == 0 {
+		return defaultDrawRate
+	}
+	return float64(e.Draws) / float64(e.Matches)
+}
+
+// Probabilities returns the 1X2 probabilities of a fixture. Elo only gives
+// the expected result E, so the draw probability is the draw rate scaled by
+// 4E(1-E): highest between evenly matched teams, lower the more lopsided the
+// fixture. The rest of E goes to the home win and the rest of 1-E to the
+// away win.
+func (e *Elo) Probabilities(home, away int) simulate.Outcome {
+	expected := e.Expected(home, away)
+	// Capping the rate at 0.5 keeps both wins non-negative.
+	draw := min(e.DrawRate(), 0.5) * 4 * expected * (1 - expected)
+	return simulate.Outcome{
+		Home: expected - draw/2,
+		Draw: draw,
+		Away: 1 - expected - draw/2,
+	}
+}
+
+// Outcome implements simulate.Model. Teams without a rating are rated
+// Initial, as for promoted teams.
+func (e *Elo) Outcome(f models.Fixture) (simulate.Outcome, error) {
+	return e.Probabilities(f.Teams.Home.ID, f.Teams.Away.ID), nil
+}
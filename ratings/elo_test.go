@@ -0,0 +1,62 @@
+package ratings_test
+
+import (
+	"encoding/json"
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/0ffsideCompass/api-football-go-client/internal/fixturetest"
+	"github.com/0ffsideCompass/api-football-go-client/ratings"
+	"github.com/0ffsideCompass/api-football-go-client/simulate"
+	"github.com/0ffsideCompass/api-football-go-client/table"
+)
+
+func TestElo(t *testing.T) {
+	elo := ratings.NewElo()
+	elo.Update(result(0, fixturetest.TeamA, fixturetest.TeamB, 1, 0))
+	expected := 1 / (1 + math.Pow(10, -65.0/400))
+	assert.InDelta(t, 1500+20*(1-expected), elo.Rating(fixturetest.TeamA.ID), 1e-9)
+	assert.InDelta(t, 3000, elo.Rating(fixturetest.TeamA.ID)+elo.Rating(fixturetest.TeamB.ID), 1e-9)
+	assert.Equal(t, 1500.0, elo.Rating(fixturetest.TeamC.ID), "unrated teams start at Initial")
+
+	// A three-goal margin moves ratings (11+3)/8 times as far.
+	scaled, plain := ratings.NewElo(), ratings.NewElo()
+	plain.MarginScaling = false
+	scaled.Update(result(0, fixturetest.TeamA, fixturetest.TeamB, 3, 0))
+	plain.Update(result(0, fixturetest.TeamA, fixturetest.TeamB, 3, 0))
+	assert.InDelta(t, 14.0/8*(plain.Rating(fixturetest.TeamA.ID)-1500), scaled.Rating(fixturetest.TeamA.ID)-1500, 1e-9)
+
+	// Fit plays results oldest first.
+	elo = ratings.NewElo()
+	elo.Fit([]table.Result{
+		result(2, fixturetest.TeamB, fixturetest.TeamA, 1, 1),
+		result(1, fixturetest.TeamA, fixturetest.TeamB, 2, 0),
+	})
+	assert.Equal(t, 2, elo.Matches)
+	assert.Equal(t, 0.5, elo.DrawRate())
+	step := ratings.NewElo()
+	step.Update(result(1, fixturetest.TeamA, fixturetest.TeamB, 2, 0))
+	step.Update(result(2, fixturetest.TeamB, fixturetest.TeamA, 1, 1))
+	assert.Equal(t, step.Ratings, elo.Ratings)
+
+	o := elo.Probabilities(fixturetest.TeamA.ID, fixturetest.TeamB.ID)
+	assert.InDelta(t, 1, o.Home+o.Draw+o.Away, 1e-12)
+	assert.Greater(t, o.Home, o.Away)
+	assert.InDelta(t, elo.Expected(fixturetest.TeamA.ID, fixturetest.TeamB.ID), o.Home+o.Draw/2, 1e-12)
+	even := ratings.NewElo()
+	even.HomeAdvantage = 0
+	assert.Equal(t, simulate.Outcome{Home: 0.37, Draw: 0.26, Away: 0.37}, roundOutcome(even.Probabilities(1, 2)))
+
+	data, err := json.Marshal(elo)
+	assert.NoError(t, err)
+	var loaded ratings.Elo
+	assert.NoError(t, json.Unmarshal(data, &loaded))
+	assert.Equal(t, *elo, loaded)
+}
+
+func roundOutcome(o simulate.Outcome) simulate.Outcome {
+	r := func(x float64) float64 { return math.Round(x*1e9) / 1e9 }
+	return simulate.Outcome{Home: r(o.Home), Draw: r(o.Draw), Away: r(o.Away)}
+}
@@ -0,0 +1,90 @@
+package ratings
+
+import (
+	"errors"
+	"math"
+	"math/rand/v2"
+
+	"github.com/0ffsideCompass/api-football-go-client/simulate"
+)
+
+// ScoreMatrix holds the probabilities of the scorelines of a fixture:
+// m[h][a] is the probability that the home team scores h goals and the away
+// team a. Scores above the model's MaxGoals are left out, and the rest
+// renormalized to sum to 1.
+type ScoreMatrix [][]float64
+
+// Outcome returns the probabilities of a home win, a draw and an away win.
+func (m ScoreMatrix) Outcome() simulate.Outcome {
+	var o simulate.Outcome
+	for h, row := range m {
+		for a, p := range row {
+			switch {
+			case h > a:
+				o.Home += p
+			case h < a:
+				o.Away += p
+			default:
+				o.Draw += p
+			}
+		}
+	}
+	return o
+}
+
+// OverUnder returns the probabilities of more and fewer total goals than
+// line, which must be a half-goal line such as 2.5 so no score pushes.
+func (m ScoreMatrix) OverUnder(line float64) (over, under float64, err error) {
+	if line < 0 || line != math.Floor(line)+0.5 {
+		return 0, 0, errors.New("over/under line must be a positive half-goal line")
+	}
+	for h, row := range m {
+		for a, p := range row {
+			if float64(h+a) > line {
+				over += p
+			} else {
+				under += p
+			}
+		}
+	}
+	return over, under, nil
+}
+
+// BothTeamsToScore returns the probability that both teams score.
+func (m ScoreMatrix) BothTeamsToScore() float64 {
+	var p float64
+	for h := 1; h < len(m); h++ {
+		for a := 1; a < len(m[h]); a++ {
+			p += m[h][a]
+		}
+	}
+	return p
+}
+
+// MostLikely returns the most likely scoreline.
+func (m ScoreMatrix) MostLikely() (home, away int) {
+	best := -1.0
+	for h, row := range m {
+		for a, p := range row {
+			if p > best {
+				best, home, away = p, h, a
+			}
+		}
+	}
+	return home, away
+}
+
+// Sample draws a scoreline at random.
+func (m ScoreMatrix) Sample(rng *rand.Rand) (home, away int) {
+	u := rng.Float64()
+	for h, row := range m {
+		for a, p := range row {
+			if u < p {
+				return h, a
+			}
+			u -= p
+		}
+	}
+	// Rounding left u just above the total: return the last scoreline.
+	return len(m) - 1, len(m[len(m)-1]) - 1
+}
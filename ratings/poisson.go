@@ -0,0 +1,315 @@
+package ratings
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"time"
+
+	"github.com/0ffsideCompass/api-football-go-client/models"
+	"github.com/0ffsideCompass/api-football-go-client/simulate"
+	"github.com/0ffsideCompass/api-football-go-client/table"
+)
+
+const (
+	// defaultMaxGoals is the highest score per team in a ScoreMatrix when
+	// Poisson.MaxGoals is zero.
+	defaultMaxGoals = 10
+	// maxIterations and tolerance bound the fitting of the strengths.
+	maxIterations = 500
+	tolerance     = 1e-10
+	// maxRho bounds the Dixon-Coles correction.
+	maxRho = 0.5
+)
+
+// Strength is the attack and defence of a team, as multipliers of the
+// league average: an Attack of 1.2 scores 20% more than average, and a
+// Defence of 0.8 concedes 20% less.
+type Strength struct {
+	Attack  float64 `json:"attack"`
+	Defence float64 `json:"defence"`
+}
+
+// Poisson is an attack/defence model: the home team of a fixture scores a
+// Poisson number of goals with mean Attack(home) × Defence(away) ×
+// HomeAdvantage, and the away team Attack(away) × Defence(home). Set the
+// configuration fields, then call Fit; the fitted model marshals to JSON.
+type Poisson struct {
+	// DixonColes applies the Dixon-Coles correction, which adjusts the
+	// probabilities of 0-0, 1-0, 0-1 and 1-1 that independent Poisson goals
+	// get wrong.
+	DixonColes bool `json:"dixonColes"`
+	// HalfLife, if positive, weights each result by its age relative to the
+	// latest one, halving its weight every HalfLife, so recent form counts
+	// more.
+	HalfLife time.Duration `json:"halfLife,omitempty"`
+	// MaxGoals is the highest score per team in a ScoreMatrix. Zero means
+	// 10.
+	MaxGoals int `json:"maxGoals,omitempty"`
+
+	// Teams holds the fitted strength of each team, keyed by team ID.
+	Teams map[int]Strength `json:"teams"`
+	// HomeAdvantage is the fitted multiplier of the home team's scoring.
+	HomeAdvantage float64 `json:"homeAdvantage"`
+	// Rho is the fitted Dixon-Coles parameter, zero without the correction.
+	Rho float64 `json:"rho"`
+	// Matches is the number of results fitted, and FittedTo the date of the
+	// latest.
+	Matches  int       `json:"matches"`
+	FittedTo time.Time `json:"fittedTo"`
+}
+
+// Fit fits the strengths, home advantage and, with DixonColes, Rho to
+// results by maximum likelihood, replacing any earlier fit. Teams should be
+// linked by the results, as in a league season: strengths are only
+// comparable between teams that played common opponents.
+func (p *Poisson) Fit(results []table.Result) error {
+	if len(results) == 0 {
+		return errors.New("no results to fit")
+	}
+	latest := results[0].Date
+	for _, r := range results {
+		if r.Date.After(latest) {
+			latest = r.Date
+		}
+	}
+	weights := make([]float64, len(results))
+	for i, r := range results {
+		weights[i] = 1
+		if p.HalfLife > 0 {
+			weights[i] = math.Pow(0.5, float64(latest.Sub(r.Date))/float64(p.HalfLife))
+		}
+	}
+
+	attack := make(map[int]float64)
+	defence := make(map[int]float64)
+	for _, r := range results {
+		attack[r.Home.ID], defence[r.Home.ID] = 1, 1
+		attack[r.Away.ID], defence[r.Away.ID] = 1, 1
+	}
+	home := 1.0
+
+	// Maximize the likelihood one block of parameters at a time: each
+	// update is the closed-form optimum given the others.
+	for range maxIterations {
+		change := 0.0
+		update := func(values map[int]float64, num, den map[int]float64) {
+			for id, n := range num {
+				if den[id] == 0 {
+					continue
+				}
+				next := n / den[id]
+				change = max(change, math.Abs(next-values[id]))
+				values[id] = next
+			}
+		}
+
+		goals, expected := make(map[int]float64), make(map[int]float64)
+		for i, r := range results {
+			w := weights[i]
+			goals[r.Home.ID] += w * float64(r.HomeGoals)
+			goals[r.Away.ID] += w * float64(r.AwayGoals)
+			expected[r.Home.ID] += w * defence[r.Away.ID] * home
+			expected[r.Away.ID] += w * defence[r.Home.ID]
+		}
+		update(attack, goals, expected)
+
+		conceded, expected := make(map[int]float64), make(map[int]float64)
+		for i, r := range results {
+			w := weights[i]
+			conceded[r.Away.ID] += w * float64(r.HomeGoals)
+			conceded[r.Home.ID] += w * float64(r.AwayGoals)
+			expected[r.Away.ID] += w * attack[r.Home.ID] * home
+			expected[r.Home.ID] += w * attack[r.Away.ID]
+		}
+		update(defence, conceded, expected)
+
+		var homeGoals, homeExpected float64
+		for i, r := range results {
+			homeGoals += weights[i] * float64(r.HomeGoals)
+			homeExpected += weights[i] * attack[r.Home.ID] * defence[r.Away.ID]
+		}
+		if homeExpected > 0 {
+			next := homeGoals / homeExpected
+			change = max(change, math.Abs(next-home))
+			home = next
+		}
+
+		// Rates only depend on attack × defence, so fix the scale by making
+		// the average attack 1.
+		var total float64
+		for _, a := range attack {
+			total += a
+		}
+		if scale := total / float64(len(attack)); scale > 0 {
+			for id := range attack {
+				attack[id] /= scale
+				defence[id] *= scale
+			}
+		}
+		if change < tolerance {
+			break
+		}
+	}
+
+	p.Teams = make(map[int]Strength, len(attack))
+	for id, a := range attack {
+		p.Teams[id] = Strength{Attack: a, Defence: defence[id]}
+	}
+	p.HomeAdvantage = home
+	p.Matches = len(results)
+	p.FittedTo = latest
+	p.Rho = 0
+	if p.DixonColes {
+		p.Rho = p.fitRho(results, weights)
+	}
+	return nil
+}
+
+// fitRho returns the Dixon-Coles parameter maximizing the likelihood of
+// results given the fitted strengths. The log-likelihood is concave in rho,
+// so a golden-section search finds it.
+func (p *Poisson) fitRho(results []table.Result, weights []float64) float64 {
+	lo, hi := -maxRho, maxRho
+	// Keep every correction factor positive.
+	for _, r := range results {
+		if r.HomeGoals > 1 || r.AwayGoals > 1 {
+			continue
+		}
+		lambda, mu := p.rates(r.Home.ID, r.Away.ID)
+		if lambda > 0 {
+			lo = max(lo, -1/lambda)
+		}
+		if mu > 0 {
+			lo = max(lo, -1/mu)
+		}
+		if lambda*mu > 0 {
+			hi = min(hi, 1/(lambda*mu))
+		}
+	}
+	const margin = 1e-6
+	lo, hi = lo+margin, hi-margin
+
+	likelihood := func(rho float64) float64 {
+		var ll float64
+		for i, r := range results {
+			lambda, mu := p.rates(r.Home.ID, r.Away.ID)
+			if tau := dixonColes(r.HomeGoals, r.AwayGoals, lambda, mu, rho); tau > 0 {
+				ll += weights[i] * math.Log(tau)
+			}
+		}
+		return ll
+	}
+	ratio := (math.Sqrt(5) - 1) / 2
+	a, b := hi-ratio*(hi-lo), lo+ratio*(hi-lo)
+	fa, fb := likelihood(a), likelihood(b)
+	for hi-lo > 1e-8 {
+		if fa > fb {
+			hi, b, fb = b, a, fa
+			a = hi - ratio*(hi-lo)
+			fa = likelihood(a)
+		} else {
+			lo, a, fa = a, b, fb
+			b = lo + ratio*(hi-lo)
+			fb = likelihood(b)
+		}
+	}
+	return (lo + hi) / 2
+}
+
+// dixonColes returns the Dixon-Coles correction factor of a scoreline.
+func dixonColes(home, away int, lambda, mu, rho float64) float64 {
+	switch {
+	case home == 0 && away == 0:
+		return 1 - lambda*mu*rho
+	case home == 0 && away == 1:
+		return 1 + lambda*rho
+	case home == 1 && away == 0:
+		return 1 + mu*rho
+	case home == 1 && away == 1:
+		return 1 - rho
+	default:
+		return 1
+	}
+}
+
+// rates returns the expected goals of each team, without checking that
+// they are rated.
+func (p *Poisson) rates(home, away int) (lambda, mu float64) {
+	h, a := p.Teams[home], p.Teams[away]
+	return h.Attack * a.Defence * p.HomeAdvantage, a.Attack * h.Defence
+}
+
+// ExpectedGoals returns the expected goals of the home and away teams of a
+// fixture.
+func (p *Poisson) ExpectedGoals(home, away int) (float64, float64, error) {
+	for _, id := range []int{home, away} {
+		if _, ok := p.Teams[id]; !ok {
+			return 0, 0, fmt.Errorf("team %d is not rated", id)
+		}
+	}
+	lambda, mu := p.rates(home, away)
+	return lambda, mu, nil
+}
+
+// ScoreMatrix returns the scoreline probabilities of a fixture.
+func (p *Poisson) ScoreMatrix(home, away int) (ScoreMatrix, error) {
+	lambda, mu, err := p.ExpectedGoals(home, away)
+	if err != nil {
+		return nil, err
+	}
+	maxGoals := p.MaxGoals
+	if maxGoals <= 0 {
+		maxGoals = defaultMaxGoals
+	}
+	homeProbs, awayProbs := poissonProbs(lambda, maxGoals), poissonProbs(mu, maxGoals)
+
+	m := make(ScoreMatrix, maxGoals+1)
+	var total float64
+	for h := range m {
+		m[h] = make([]float64, maxGoals+1)
+		for a := range m[h] {
+			// Rho was fitted on other rates, and may overcorrect these.
+			m[h][a] = homeProbs[h] * awayProbs[a] * max(0, dixonColes(h, a, lambda, mu, p.Rho))
+			total += m[h][a]
+		}
+	}
+	for _, row := range m {
+		for a := range row {
+			row[a] /= total
+		}
+	}
+	return m, nil
+}
+
+// poissonProbs returns the probabilities of 0 to n events of a Poisson
+// distribution with mean lambda.
+func poissonProbs(lambda float64, n int) []float64 {
+	probs := make([]float64, n+1)
+	probs[0] = math.Exp(-lambda)
+	for k := 1; k <= n; k++ {
+		probs[k] = probs[k-1] * lambda / float64(k)
+	}
+	return probs
+}
+
+// Outcome implements simulate.Model. It returns an error if a team of the
+// fixture is not rated.
+func (p *Poisson) Outcome(f models.Fixture) (simulate.Outcome, error) {
+	m, err := p.ScoreMatrix(f.Teams.Home.ID, f.Teams.Away.ID)
+	if err != nil {
+		return simulate.Outcome{}, fmt.Errorf("fixture %d: %w", f.Fixture.ID, err)
+	}
+	return m.Outcome(), nil
+}
+
+// ScoreSampler implements simulate.ScoreModel. The score matrix of the
+// fixture is computed once and sampled on every run. It returns an error if
+// a team of the fixture is not rated.
+func (p *Poisson) ScoreSampler(f models.Fixture) (simulate.ScoreSampler, error) {
+	m, err := p.ScoreMatrix(f.Teams.Home.ID, f.Teams.Away.ID)
+	if err != nil {
+		return nil, fmt.Errorf("fixture %d: %w", f.Fixture.ID, err)
+	}
+	return m.Sample, nil
+}
@@ -0,0 +1,125 @@
+package ratings_test
+
+import (
+	"encoding/json"
+	"math"
+	"math/rand/v2"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/0ffsideCompass/api-football-go-client/internal/fixturetest"
+	"github.com/0ffsideCompass/api-football-go-client/models"
+	"github.com/0ffsideCompass/api-football-go-client/ratings"
+	"github.com/0ffsideCompass/api-football-go-client/table"
+)
+
+func TestPoissonSymmetric(t *testing.T) {
+	// Every match ending 1-1 gives every team average strengths, no home
+	// advantage and an expected goal each.
+	teams := []models.TeamRef{fixturetest.TeamA, fixturetest.TeamB, fixturetest.TeamC, fixturetest.TeamD}
+	model := &ratings.Poisson{}
+	assert.NoError(t, model.Fit(roundRobin(teams, 1, func(_, _ models.TeamRef) (int, int) { return 1, 1 })))
+	assert.Equal(t, 12, model.Matches)
+	assert.InDelta(t, 1, model.HomeAdvantage, 1e-9)
+	for _, s := range model.Teams {
+		assert.InDelta(t, 1, s.Attack, 1e-9)
+		assert.InDelta(t, 1, s.Defence, 1e-9)
+	}
+
+	m, err := model.ScoreMatrix(fixturetest.TeamA.ID, fixturetest.TeamB.ID)
+	assert.NoError(t, err)
+	assert.Len(t, m, 11)
+	// Truncating at 10 goals a side renormalizes slightly.
+	assert.InDelta(t, math.Exp(-2), m[0][0], 1e-7)
+	o := m.Outcome()
+	assert.InDelta(t, o.Home, o.Away, 1e-12)
+	assert.InDelta(t, 1, o.Home+o.Draw+o.Away, 1e-12)
+	over, under, err := m.OverUnder(2.5)
+	assert.NoError(t, err)
+	assert.InDelta(t, 5*math.Exp(-2), under, 1e-7)
+	assert.InDelta(t, 1, over+under, 1e-12)
+	assert.InDelta(t, math.Pow(1-math.Exp(-1), 2), m.BothTeamsToScore(), 1e-7)
+	home, away := m.MostLikely()
+	assert.Equal(t, [2]int{0, 0}, [2]int{home, away}, "0-0, 1-0, 0-1 and 1-1 tie; the first wins")
+
+	_, _, err = m.OverUnder(2)
+	assert.EqualError(t, err, "over/under line must be a positive half-goal line")
+	_, err = model.ScoreMatrix(fixturetest.TeamA.ID, 99)
+	assert.EqualError(t, err, "team 99 is not rated")
+	_, err = model.Outcome(fixturetest.Upcoming(5, 1, "", fixturetest.TeamA, models.TeamRef{ID: 99}))
+	assert.EqualError(t, err, "fixture 5: team 99 is not rated")
+	_, err = model.ScoreSampler(fixturetest.Upcoming(5, 1, "", fixturetest.TeamA, models.TeamRef{ID: 99}))
+	assert.EqualError(t, err, "fixture 5: team 99 is not rated")
+
+	sample, err := model.ScoreSampler(fixturetest.Upcoming(6, 1, "", fixturetest.TeamA, fixturetest.TeamB))
+	assert.NoError(t, err)
+	rng := rand.New(rand.NewPCG(1, 2))
+	home, away = sample(rng)
+	rng = rand.New(rand.NewPCG(1, 2))
+	wantHome, wantAway := m.Sample(rng)
+	assert.Equal(t, [2]int{wantHome, wantAway}, [2]int{home, away}, "the sampler draws from the score matrix")
+	assert.EqualError(t, (&ratings.Poisson{}).Fit(nil), "no results to fit")
+}
+
+func TestPoissonRecoversStrengths(t *testing.T) {
+	truth := &ratings.Poisson{
+		Teams: map[int]ratings.Strength{
+			1: {Attack: 1.6, Defence: 0.6},
+			2: {Attack: 1.1, Defence: 0.9},
+			3: {Attack: 0.8, Defence: 1.2},
+			4: {Attack: 0.5, Defence: 1.5},
+		},
+		HomeAdvantage: 1.3,
+		Rho:           -0.12,
+	}
+	rng := rand.New(rand.NewPCG(1, 2))
+	teams := []models.TeamRef{fixturetest.TeamA, fixturetest.TeamB, fixturetest.TeamC, fixturetest.TeamD}
+	results := roundRobin(teams, 800, func(home, away models.TeamRef) (int, int) {
+		m, err := truth.ScoreMatrix(home.ID, away.ID)
+		assert.NoError(t, err)
+		return m.Sample(rng)
+	})
+
+	model := &ratings.Poisson{DixonColes: true}
+	assert.NoError(t, model.Fit(results))
+	assert.InDelta(t, 1.3, model.HomeAdvantage, 0.05)
+	assert.InDelta(t, -0.12, model.Rho, 0.04)
+	// Strengths are only defined up to scale: compare ratios.
+	for id, s := range truth.Teams {
+		got := model.Teams[id]
+		assert.InDelta(t, s.Attack/truth.Teams[1].Attack, got.Attack/model.Teams[1].Attack, 0.05, "attack of %d", id)
+		assert.InDelta(t, s.Defence*s.Attack, got.Defence*got.Attack, 0.08, "defence of %d", id)
+	}
+
+	data, err := json.Marshal(model)
+	assert.NoError(t, err)
+	var loaded ratings.Poisson
+	assert.NoError(t, json.Unmarshal(data, &loaded))
+	want, _ := model.ScoreMatrix(fixturetest.TeamC.ID, fixturetest.TeamA.ID)
+	got, err := loaded.ScoreMatrix(fixturetest.TeamC.ID, fixturetest.TeamA.ID)
+	assert.NoError(t, err)
+	assert.Equal(t, want, got)
+}
+
+func TestPoissonHalfLife(t *testing.T) {
+	teams := []models.TeamRef{fixturetest.TeamA, fixturetest.TeamB, fixturetest.TeamC}
+	var results []table.Result
+	// A loses early on, then wins.
+	for i, r := range roundRobin(teams, 10, func(home, away models.TeamRef) (int, int) { return 1, 1 }) {
+		switch {
+		case r.Home.ID == fixturetest.TeamA.ID && i < 30:
+			r.HomeGoals = 0
+		case r.Home.ID == fixturetest.TeamA.ID:
+			r.HomeGoals = 3
+		}
+		results = append(results, r)
+	}
+	flat := &ratings.Poisson{}
+	decayed := &ratings.Poisson{HalfLife: 7 * 24 * time.Hour}
+	assert.NoError(t, flat.Fit(results))
+	assert.NoError(t, decayed.Fit(results))
+	assert.Greater(t, decayed.Teams[fixturetest.TeamA.ID].Attack, flat.Teams[fixturetest.TeamA.ID].Attack)
+	assert.Equal(t, results[len(results)-1].Date, decayed.FittedTo)
+}
@@ -0,0 +1,73 @@
+package ratings_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/0ffsideCompass/api-football-go-client/internal/fixturetest"
+	"github.com/0ffsideCompass/api-football-go-client/models"
+	"github.com/0ffsideCompass/api-football-go-client/ratings"
+	"github.com/0ffsideCompass/api-football-go-client/simulate"
+	"github.com/0ffsideCompass/api-football-go-client/table"
+)
+
+var ratingsStart = time.Date(2023, 8, 1, 15, 0, 0, 0, time.UTC)
+
+func result(day int, home, away models.TeamRef, homeGoals, awayGoals int) table.Result {
+	return table.Result{
+		FixtureID: day*100 + home.ID*10 + away.ID,
+		Date:      ratingsStart.AddDate(0, 0, day),
+		Home:      home,
+		Away:      away,
+		HomeGoals: homeGoals,
+		AwayGoals: awayGoals,
+	}
+}
+
+// roundRobin returns a double round robin between teams, scored by score.
+func roundRobin(teams []models.TeamRef, rounds int, score func(home, away models.TeamRef) (int, int)) []table.Result {
+	var results []table.Result
+	day := 0
+	for range rounds {
+		for _, home := range teams {
+			for _, away := range teams {
+				if home.ID == away.ID {
+					continue
+				}
+				h, a := score(home, away)
+				results = append(results, result(day, home, away, h, a))
+				day++
+			}
+		}
+	}
+	return results
+}
+
+func TestRatingsDriveSimulation(t *testing.T) {
+	teams := []models.TeamRef{fixturetest.TeamA, fixturetest.TeamB, fixturetest.TeamC, fixturetest.TeamD}
+	goals := map[int]int{1: 3, 2: 2, 3: 1, 4: 0}
+	results := roundRobin(teams, 1, func(home, away models.TeamRef) (int, int) {
+		return goals[home.ID], goals[away.ID]
+	})
+	model := &ratings.Poisson{}
+	assert.NoError(t, model.Fit(results))
+	elo := ratings.NewElo()
+	elo.Fit(results)
+	assert.Greater(t, elo.Rating(fixturetest.TeamA.ID), elo.Rating(fixturetest.TeamD.ID))
+
+	standings := table.New(nil, table.DefaultRules).With(results...).Standings()
+	remaining := []models.Fixture{
+		fixturetest.Upcoming(900, 60, "", fixturetest.TeamD, fixturetest.TeamA),
+		fixturetest.Upcoming(901, 60, "", fixturetest.TeamB, fixturetest.TeamC),
+	}
+	for _, m := range []simulate.Model{model, elo} {
+		sim := &simulate.Simulator{Standings: standings, Fixtures: remaining, Model: m, Runs: 500, Seed: 3}
+		res, err := sim.Run(context.Background())
+		assert.NoError(t, err)
+		assert.Equal(t, fixturetest.TeamA, res.Teams[0].Team)
+		assert.Equal(t, 1.0, res.Teams[0].RankProbabilities[0])
+	}
+}
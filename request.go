@@ -0,0 +1,127 @@
+package client
+
+import (
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// dateParams are the parameters that hold a YYYY-MM-DD date.
+var dateParams = map[string]bool{"date": true, "from": true, "to": true}
+
+// CanonicalRequest is the normalized form of an API request: the endpoint
+// path and one normalized string per query parameter. Logically equal
+// requests have equal canonical forms regardless of how their parameters
+// were built (int or float64 IDs, time.Time or string dates, map iteration
+// order), so Encode is suitable as a key for caches, dedupers and
+// recorders.
+type CanonicalRequest struct {
+	// Endpoint is the endpoint path, e.g. "fixtures/lineups".
+	Endpoint string
+	// Params holds the normalized value of each query parameter.
+	Params map[string]string
+}
+
+// NewCanonicalRequest normalizes a request to endpoint with params, the
+// same map the endpoint methods accept. Parameters with a nil value are
+// dropped.
+func NewCanonicalRequest(endpoint string, params map[string]any) CanonicalRequest {
+	req := CanonicalRequest{
+		Endpoint: strings.Trim(endpoint, "/"),
+		Params:   make(map[string]string, len(params)),
+	}
+	for key, value := range params {
+		if value == nil {
+			continue
+		}
+		req.Params[key] = normalizeParam(key, value)
+	}
+	return req
+}
+
+// Query returns the encoded query string, with keys in sorted order.
+func (r CanonicalRequest) Query() string {
+	values := make(url.Values, len(r.Params))
+	for key, value := range r.Params {
+		values.Set(key, value)
+	}
+	return values.Encode()
+}
+
+// Encode returns the endpoint path followed by the sorted query string, if
+// any, e.g. "fixtures?league=39&season=2023".
+func (r CanonicalRequest) Encode() string {
+	if query := r.Query(); query != "" {
+		return r.Endpoint + "?" + query
+	}
+	return r.Endpoint
+}
+
+// URL returns the full request URL under baseURL, which must end with a
+// slash.
+func (r CanonicalRequest) URL(baseURL string) string {
+	return baseURL + r.Encode()
+}
+
+func (c *Client) formatDate(t time.Time) string {
+	return t.Format(dateFormat)
+}
+
+// normalizeParam formats the value of the query parameter key. Date
+// parameters given as a time.Time or an RFC 3339 timestamp are reduced to
+// their YYYY-MM-DD date, with surrounding whitespace dropped. Other strings,
+// such as search terms, are sent exactly as given.
+func normalizeParam(key string, value any) string {
+	if s, ok := value.(string); ok && dateParams[key] {
+		s = strings.TrimSpace(s)
+		if t, err := time.Parse(time.RFC3339, s); err == nil {
+			return t.Format(dateFormat)
+		}
+		return s
+	}
+	return paramString(value)
+}
+
+// paramString formats a query parameter value. JSON-decoded numbers arrive as
+// float64 and must render as plain integers when whole (1581037), never in
+// scientific notation (1.581037e+06), which the API rejects. Lists of IDs
+// render in the API's "id-id-id" form.
+func paramString(value any) string {
+	switch v := value.(type) {
+	case string:
+		return v
+	case float64:
+		if v == float64(int64(v)) {
+			return strconv.FormatInt(int64(v), 10)
+		}
+		return strconv.FormatFloat(v, 'f', -1, 64)
+	case float32:
+		return paramString(float64(v))
+	case time.Time:
+		return v.Format(dateFormat)
+	case []int:
+		parts := make([]string, len(v))
+		for i, n := range v {
+			parts[i] = strconv.Itoa(n)
+		}
+		return strings.Join(parts, "-")
+	case []string:
+		return strings.Join(v, "-")
+	default:
+		return fmt.Sprintf("%v", v)
+	}
+}
+
+// buildURL constructs the canonical URL of a request to endpoint (a full
+// URL under c.Domain) with the given parameters.
+func (c *Client) buildURL(endpoint string, params map[string]any) string {
+	u, err := url.Parse(endpoint)
+	if err != nil {
+		return endpoint
+	}
+
+	u.RawQuery = NewCanonicalRequest("", params).Query()
+	return u.String()
+}
@@ -0,0 +1,106 @@
+package client_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	client "github.com/0ffsideCompass/api-football-go-client"
+)
+
+func TestCanonicalRequest(t *testing.T) {
+	tests := []struct {
+		name     string
+		endpoint string
+		params   map[string]any
+		expected string
+	}{
+		{
+			name:     "no params",
+			endpoint: "timezone",
+			expected: "timezone",
+		},
+		{
+			name:     "keys are sorted",
+			endpoint: "fixtures",
+			params:   map[string]any{"season": 2023, "league": 39, "from": "2023-08-01"},
+			expected: "fixtures?from=2023-08-01&league=39&season=2023",
+		},
+		{
+			name:     "int and whole float64 IDs are equal",
+			endpoint: "fixtures",
+			params:   map[string]any{"league": float64(39), "season": 2023.0},
+			expected: "fixtures?league=39&season=2023",
+		},
+		{
+			name:     "time.Time dates are formatted",
+			endpoint: "fixtures",
+			params:   map[string]any{"date": time.Date(2023, 8, 12, 15, 0, 0, 0, time.UTC)},
+			expected: "fixtures?date=2023-08-12",
+		},
+		{
+			name:     "RFC 3339 date strings are reduced to the date",
+			endpoint: "odds",
+			params:   map[string]any{"date": "2023-08-12T15:00:00+00:00"},
+			expected: "odds?date=2023-08-12",
+		},
+		{
+			name:     "ID lists use the id-id-id form",
+			endpoint: "fixtures",
+			params:   map[string]any{"ids": []int{3, 1, 2}},
+			expected: "fixtures?ids=3-1-2",
+		},
+		{
+			name:     "strings are escaped, nil values dropped",
+			endpoint: "/teams/",
+			params:   map[string]any{"search": "Manchester United", "league": nil},
+			expected: "teams?search=Manchester+United",
+		},
+		{
+			name:     "search terms are sent as given",
+			endpoint: "teams",
+			params:   map[string]any{"search": " Manchester United "},
+			expected: "teams?search=+Manchester+United+",
+		},
+		{
+			name:     "whitespace around dates is dropped",
+			endpoint: "fixtures",
+			params:   map[string]any{"date": " 2023-08-12 ", "to": "2023-08-13T00:00:00Z "},
+			expected: "fixtures?date=2023-08-12&to=2023-08-13",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := client.NewCanonicalRequest(tt.endpoint, tt.params)
+			assert.Equal(t, tt.expected, req.Encode())
+			assert.Equal(t, "https://example.com/"+tt.expected, req.URL("https://example.com/"))
+		})
+	}
+}
+
+func TestRequestsUseCanonicalURLs(t *testing.T) {
+	// The legacy date-range method and the map-based Fixture call for the
+	// same query must produce the same URL, and therefore the same cache key.
+	from := time.Date(2023, 8, 1, 0, 0, 0, 0, time.UTC)
+	to := time.Date(2023, 8, 31, 0, 0, 0, 0, time.UTC)
+
+	legacyMock := newMockClient()
+	legacy, err := client.New("test-key", legacyMock)
+	assert.NoError(t, err)
+	_, err = legacy.FixtureByDateAndLeague(39, 2023, from, to)
+	assert.NoError(t, err)
+
+	mapMock := newMockClient()
+	mapped, err := client.New("test-key", mapMock)
+	assert.NoError(t, err)
+	_, err = mapped.Fixture(map[string]any{"to": to, "season": 2023.0, "league": "39", "from": "2023-08-01"})
+	assert.NoError(t, err)
+
+	assert.Equal(t, legacyMock.LastRequest.URL.String(), mapMock.LastRequest.URL.String())
+	assert.Equal(t,
+		client.NewCanonicalRequest("fixtures", map[string]any{"league": 39, "season": 2023, "from": from, "to": to}).URL(legacy.Domain),
+		legacyMock.LastRequest.URL.String(),
+	)
+}
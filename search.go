@@ -1,8 +1,8 @@
 package client
 
 import (
+	"context"
 	"fmt"
-	"net/url"
 )
 
 // Type identifies the kind of entity a Search call looks up.
@@ -26,13 +26,20 @@ func (t Type) String() string {
 //
 // Note: the API requires search queries to be at least 3 characters
 // (4 for players), and player searches may additionally require a league
-// or team filter; such errors are reported in the response body.
+// or team filter. The API rejects such searches in the response body,
+// which is returned as an *APIError matching ErrInvalidParameter.
 func (c *Client) Search(q string, t Type) ([]byte, error) {
-	values := url.Values{}
-	values.Set("search", q)
-	fullURL := fmt.Sprintf("%s%ss?%s", c.Domain, t.String(), values.Encode())
+	return c.SearchContext(context.Background(), q, t)
+}
+
+// SearchContext is like Search but with a context.
+func (c *Client) SearchContext(ctx context.Context, q string, t Type) ([]byte, error) {
+	fullURL := c.buildURL(
+		fmt.Sprintf("%s%ss", c.Domain, t.String()),
+		map[string]any{"search": q},
+	)
 
-	body, err := c.get(fullURL)
+	body, err := c.get(ctx, fullURL)
 	if err != nil {
 		return nil, fmt.Errorf("error getting search results: %w", err)
 	}
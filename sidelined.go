@@ -1,6 +1,7 @@
 package client
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 
@@ -23,14 +24,23 @@ const (
 
 func (c *Client) Sidelined(
 	params map[string]any,
+) (*models.SidelinedResponse, error) {
+	return c.SidelinedContext(context.Background(), params)
+}
+
+// SidelinedContext is like Sidelined but with a context.
+func (c *Client) SidelinedContext(
+	ctx context.Context,
+	params map[string]any,
 ) (*models.SidelinedResponse, error) {
 	// Validate the parameters
-	if err := requireOneIntParam(params, "coach", "player"); err != nil {
+	if err := c.validateParams(sidelinedEndpoint, params); err != nil {
 		return nil, err
 	}
 
 	endpointURL := fmt.Sprintf("%s%s", c.Domain, sidelinedEndpoint)
 	body, err := c.get(
+		ctx,
 		c.buildURL(
 			endpointURL,
 			params,
@@ -52,3 +62,8 @@ func (c *Client) Sidelined(
 
 	return &resp, nil
 }
+
+// SidelinedWith is like SidelinedContext but takes typed parameters.
+func (c *Client) SidelinedWith(ctx context.Context, params SidelinedParams) (*models.SidelinedResponse, error) {
+	return c.SidelinedContext(ctx, params.Encode())
+}
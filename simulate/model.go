@@ -0,0 +1,127 @@
+package simulate
+
+import (
+	"fmt"
+	"math/rand/v2"
+
+	"github.com/0ffsideCompass/api-football-go-client/models"
+	"github.com/0ffsideCompass/api-football-go-client/odds"
+)
+
+// Outcome holds the probabilities of a home win, a draw and an away win.
+type Outcome struct {
+	Home, Draw, Away float64
+}
+
+// normalize scales o so that its probabilities sum to 1.
+func (o Outcome) normalize() (Outcome, error) {
+	total := o.Home + o.Draw + o.Away
+	if o.Home < 0 || o.Draw < 0 || o.Away < 0 || total <= 0 {
+		return Outcome{}, fmt.Errorf("invalid outcome probabilities %v", o)
+	}
+	return Outcome{Home: o.Home / total, Draw: o.Draw / total, Away: o.Away / total}, nil
+}
+
+// Model gives the outcome probabilities of a fixture. It is called once per
+// fixture, before the simulations start. The probabilities need not sum to
+// 1; they are normalized.
+type Model interface {
+	Outcome(f models.Fixture) (Outcome, error)
+}
+
+// ScoreModel is a Model that also samples scorelines, so simulated goal
+// differences are realistic. Without one, a simulated win is 1-0 and a draw
+// 0-0. ScoreSampler is called once per fixture, after Outcome and before the
+// simulations start, so the work of setting up a fixture is not repeated on
+// every run.
+type ScoreModel interface {
+	Model
+	ScoreSampler(f models.Fixture) (ScoreSampler, error)
+}
+
+// ScoreSampler samples the scoreline of a fixture. It is called from several
+// goroutines at once, each with its own rng.
+type ScoreSampler func(rng *rand.Rand) (home, away int)
+
+// ModelFunc adapts a function to the Model interface, to plug in a rating
+// model.
+type ModelFunc func(f models.Fixture) (Outcome, error)
+
+// Outcome implements Model.
+func (fn ModelFunc) Outcome(f models.Fixture) (Outcome, error) {
+	return fn(f)
+}
+
+// Probabilities is a Model holding the outcome probabilities of fixtures,
+// keyed by fixture ID.
+type Probabilities map[int]Outcome
+
+// Outcome implements Model.
+func (p Probabilities) Outcome(f models.Fixture) (Outcome, error) {
+	o, ok := p[f.Fixture.ID]
+	if !ok {
+		return Outcome{}, fmt.Errorf("no probabilities for fixture %d", f.Fixture.ID)
+	}
+	return o, nil
+}
+
+// FromPredictions returns the win, draw and loss percents of /predictions
+// responses, keyed by fixture ID: the endpoint answers for one fixture at a
+// time and does not echo its ID.
+func FromPredictions(predictions map[int]models.Prediction) (Probabilities, error) {
+	probs := make(Probabilities, len(predictions))
+	for id, p := range predictions {
+		home, draw, away, ok := p.Percents()
+		if !ok {
+			return nil, fmt.Errorf("fixture %d: prediction percents missing or malformed", id)
+		}
+		probs[id] = Outcome{Home: home / 100, Draw: draw / 100, Away: away / 100}
+	}
+	return probs, nil
+}
+
+// matchWinnerBet is the ID of the "Match Winner" bet of the /odds endpoint.
+const matchWinnerBet = 1
+
+// FromOdds returns margin-free outcome probabilities from the "Match Winner"
+// markets among markets, keyed by fixture ID. The Shin probabilities of each
+// bookmaker are averaged. Fixtures without a complete Home/Draw/Away market
+// are left out.
+func FromOdds(markets []odds.Market) Probabilities {
+	sums := make(map[int]Outcome)
+	counts := make(map[int]int)
+	for _, m := range markets {
+		if m.BetID != matchWinnerBet {
+			continue
+		}
+		fair, _ := m.FairShin()
+		var o Outcome
+		found := 0
+		for i, s := range m.Selections {
+			switch s.Value {
+			case "Home":
+				o.Home = fair[i]
+			case "Draw":
+				o.Draw = fair[i]
+			case "Away":
+				o.Away = fair[i]
+			default:
+				continue
+			}
+			found++
+		}
+		if found != 3 {
+			continue
+		}
+		sum := sums[m.FixtureID]
+		sums[m.FixtureID] = Outcome{Home: sum.Home + o.Home, Draw: sum.Draw + o.Draw, Away: sum.Away + o.Away}
+		counts[m.FixtureID]++
+	}
+
+	probs := make(Probabilities, len(sums))
+	for id, sum := range sums {
+		n := float64(counts[id])
+		probs[id] = Outcome{Home: sum.Home / n, Draw: sum.Draw / n, Away: sum.Away / n}
+	}
+	return probs
+}
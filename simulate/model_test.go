@@ -0,0 +1,53 @@
+package simulate_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/0ffsideCompass/api-football-go-client/models"
+	"github.com/0ffsideCompass/api-football-go-client/odds"
+	"github.com/0ffsideCompass/api-football-go-client/simulate"
+)
+
+func TestSimulateModels(t *testing.T) {
+	var prediction models.Prediction
+	prediction.Predictions.Percent.Home = "45%"
+	prediction.Predictions.Percent.Draw = "45%"
+	prediction.Predictions.Percent.Away = "10%"
+	probs, err := simulate.FromPredictions(map[int]models.Prediction{11: prediction})
+	assert.NoError(t, err)
+	assert.InDelta(t, 0.45, probs[11].Home, 1e-12)
+	assert.InDelta(t, 0.10, probs[11].Away, 1e-12)
+
+	prediction.Predictions.Percent.Draw = ""
+	_, err = simulate.FromPredictions(map[int]models.Prediction{11: prediction})
+	assert.EqualError(t, err, "fixture 11: prediction percents missing or malformed")
+
+	market := func(bookmakerID, betID int, prices ...string) odds.Market {
+		m := odds.Market{FixtureID: 1208021, BookmakerID: bookmakerID, BetID: betID}
+		for i, odd := range prices {
+			m.Selections = append(m.Selections, odds.Selection{Value: []string{"Home", "Draw", "Away"}[i], Odd: decimal(t, odd)})
+		}
+		return m
+	}
+	probs = simulate.FromOdds([]odds.Market{
+		market(6, 1, "2.00", "3.40", "4.00"),
+		market(8, 1, "2.10", "3.30", "3.80"),
+		// Not a "Match Winner" market.
+		market(6, 12, "2.20", "1.75"),
+		// Incomplete.
+		market(11, 1, "2.00", "3.40"),
+	})
+	assert.Len(t, probs, 1)
+	o := probs[1208021]
+	assert.InDelta(t, 1, o.Home+o.Draw+o.Away, 1e-9)
+	assert.Greater(t, o.Home, o.Away)
+}
+
+func decimal(t *testing.T, s string) models.Decimal {
+	t.Helper()
+	d, err := models.ParseDecimal(s)
+	assert.NoError(t, err)
+	return d
+}
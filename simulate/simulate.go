@@ -0,0 +1,338 @@
+// Package simulate runs Monte Carlo simulations of the rest of a league
+// season, giving every team's chances of finishing in each position and
+// each qualification or relegation zone.
+//
+// A simulation starts from the current standings, plays the remaining
+// fixtures with outcome probabilities from a Model, and ranks the teams by
+// points, goal difference and goals scored, breaking remaining ties at
+// random:
+//
+//	sim, err := simulate.New(standings, remaining, simulate.FromOdds(odds.Markets(oddsResp)))
+//	// ...
+//	sim.Runs, sim.Seed = 20000, 42
+//	result, err := sim.Run(ctx)
+//	for _, team := range result.Teams {
+//		fmt.Println(team.Team.Name, team.RankProbabilities[0], team.Zones)
+//	}
+package simulate
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/rand/v2"
+	"runtime"
+	"sort"
+	"sync"
+
+	"github.com/0ffsideCompass/api-football-go-client/models"
+	"github.com/0ffsideCompass/api-football-go-client/table"
+)
+
+// DefaultRuns is the number of simulations run when Simulator.Runs is zero.
+const DefaultRuns = 10000
+
+// Simulator simulates the rest of a season. Set its fields before calling
+// Run.
+type Simulator struct {
+	// Standings is the current table. The Description of each row names the
+	// zone its rank leads to, as in the /standings endpoint.
+	Standings []models.StandingRow
+	// Fixtures are the fixtures left to play. Fixtures that already have a
+	// result or were cancelled are skipped.
+	Fixtures []models.Fixture
+	// Model gives the outcome probabilities of the fixtures.
+	Model Model
+	// Points is the points system. The zero value means table.ThreePoints.
+	Points table.PointsSystem
+	// Runs is the number of simulations. Zero means DefaultRuns.
+	Runs int
+	// Seed makes the simulations reproducible: the same seed gives the same
+	// result, whatever the number of workers.
+	Seed uint64
+	// Workers is the number of simulations run concurrently. Zero means
+	// runtime.GOMAXPROCS(0).
+	Workers int
+}
+
+// New returns a Simulator starting from the table of a /standings response.
+// Leagues with groups hold one table per group; simulate a group by setting
+// Simulator.Standings yourself.
+func New(standings *models.StandingsResponse, fixtures []models.Fixture, model Model) (*Simulator, error) {
+	if len(standings.Response) == 0 {
+		return nil, errors.New("no standings in response")
+	}
+	tables := standings.Response[0].League.Standings
+	if len(tables) != 1 {
+		return nil, fmt.Errorf("standings hold %d tables, want 1", len(tables))
+	}
+	return &Simulator{Standings: tables[0], Fixtures: fixtures, Model: model}, nil
+}
+
+// Result holds the outcome of a simulation.
+type Result struct {
+	// Runs is the number of simulations run.
+	Runs int
+	// Teams holds one entry per team, in the order of the current
+	// standings.
+	Teams []TeamResult
+}
+
+// TeamResult is the distribution of a team's final position and points.
+type TeamResult struct {
+	Team models.TeamRef
+	// RankProbabilities holds the probability of each final rank: index 0
+	// is first place.
+	RankProbabilities []float64
+	// Points maps each final points total to its probability.
+	Points map[int]float64
+	// Zones maps the Description of each zone to the probability of
+	// finishing in it, such as "Promotion - Champions League (Group Stage)"
+	// or "Relegation - Championship".
+	Zones map[string]float64
+	// ExpectedPoints and ExpectedRank are the mean final points and rank.
+	ExpectedPoints float64
+	ExpectedRank   float64
+}
+
+// match is a remaining fixture prepared for simulation.
+type match struct {
+	fixture    models.Fixture
+	home, away int // team indexes
+	outcome    Outcome
+	scores     ScoreSampler // nil without a ScoreModel
+}
+
+// tally counts the outcomes of simulations.
+type tally struct {
+	ranks  [][]int       // [team][rank]
+	points []map[int]int // [team][points]
+}
+
+func newTally(teams int) *tally {
+	t := &tally{ranks: make([][]int, teams), points: make([]map[int]int, teams)}
+	for i := range t.ranks {
+		t.ranks[i] = make([]int, teams)
+		t.points[i] = make(map[int]int)
+	}
+	return t
+}
+
+func (t *tally) merge(other *tally) {
+	for i := range t.ranks {
+		for r, n := range other.ranks[i] {
+			t.ranks[i][r] += n
+		}
+		for p, n := range other.points[i] {
+			t.points[i][p] += n
+		}
+	}
+}
+
+// Run runs the simulations. It returns ctx's error if ctx is done first,
+// and an error if the Model has no probabilities for a fixture, or a fixture
+// involves a team missing from the standings.
+func (s *Simulator) Run(ctx context.Context) (*Result, error) {
+	if len(s.Standings) == 0 {
+		return nil, errors.New("no standings to simulate from")
+	}
+	if s.Model == nil {
+		return nil, errors.New("no model")
+	}
+	runs := s.Runs
+	if runs <= 0 {
+		runs = DefaultRuns
+	}
+	workers := s.Workers
+	if workers <= 0 {
+		workers = runtime.GOMAXPROCS(0)
+	}
+
+	index := make(map[int]int, len(s.Standings))
+	for i, row := range s.Standings {
+		index[row.Team.ID] = i
+	}
+	matches, err := s.prepare(index)
+	if err != nil {
+		return nil, err
+	}
+	points := s.Points
+	if points == (table.PointsSystem{}) {
+		points = table.ThreePoints
+	}
+
+	var (
+		mu    sync.Mutex
+		total = newTally(len(s.Standings))
+		next  = make(chan int)
+		wg    sync.WaitGroup
+	)
+	for range workers {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			local := newTally(len(s.Standings))
+			for run := range next {
+				s.simulate(run, matches, points, local)
+			}
+			mu.Lock()
+			total.merge(local)
+			mu.Unlock()
+		}()
+	}
+	var ctxErr error
+	for run := 0; run < runs; run++ {
+		if ctxErr = ctx.Err(); ctxErr != nil {
+			break
+		}
+		next <- run
+	}
+	close(next)
+	wg.Wait()
+	if ctxErr != nil {
+		return nil, ctxErr
+	}
+	return s.result(runs, total), nil
+}
+
+// prepare resolves the teams and outcome probabilities of the fixtures left
+// to play.
+func (s *Simulator) prepare(index map[int]int) ([]match, error) {
+	var matches []match
+	for _, f := range s.Fixtures {
+		status := f.Fixture.Status.Short
+		if status.HasResult() || status.IsCancelled() {
+			continue
+		}
+		home, ok := index[f.Teams.Home.ID]
+		if !ok {
+			return nil, fmt.Errorf("fixture %d: team %d is not in the standings", f.Fixture.ID, f.Teams.Home.ID)
+		}
+		away, ok := index[f.Teams.Away.ID]
+		if !ok {
+			return nil, fmt.Errorf("fixture %d: team %d is not in the standings", f.Fixture.ID, f.Teams.Away.ID)
+		}
+		o, err := s.Model.Outcome(f)
+		if err != nil {
+			return nil, fmt.Errorf("error getting outcome probabilities: %w", err)
+		}
+		if o, err = o.normalize(); err != nil {
+			return nil, fmt.Errorf("fixture %d: %w", f.Fixture.ID, err)
+		}
+		m := match{fixture: f, home: home, away: away, outcome: o}
+		if scores, ok := s.Model.(ScoreModel); ok {
+			if m.scores, err = scores.ScoreSampler(f); err != nil {
+				return nil, fmt.Errorf("error getting score sampler: %w", err)
+			}
+		}
+		matches = append(matches, m)
+	}
+	return matches, nil
+}
+
+// simulate plays one season and records it in t. Each run has its own
+// random stream, so results do not depend on which worker runs it.
+func (s *Simulator) simulate(run int, matches []match, system table.PointsSystem, t *tally) {
+	rng := rand.New(rand.NewPCG(s.Seed, uint64(run)))
+	n := len(s.Standings)
+	points := make([]int, n)
+	diff := make([]int, n)
+	scored := make([]int, n)
+	for i, row := range s.Standings {
+		points[i] = row.Points
+		diff[i] = row.GoalsDiff
+		scored[i] = row.All.Goals.For
+	}
+
+	for _, m := range matches {
+		var home, away int
+		if m.scores != nil {
+			home, away = m.scores(rng)
+		} else {
+			switch u := rng.Float64(); {
+			case u < m.outcome.Home:
+				home = 1
+			case u >= m.outcome.Home+m.outcome.Draw:
+				away = 1
+			}
+		}
+		switch {
+		case home > away:
+			points[m.home] += system.Win
+			points[m.away] += system.Loss
+		case home < away:
+			points[m.home] += system.Loss
+			points[m.away] += system.Win
+		default:
+			points[m.home] += system.Draw
+			points[m.away] += system.Draw
+		}
+		diff[m.home] += home - away
+		diff[m.away] += away - home
+		scored[m.home] += home
+		scored[m.away] += away
+	}
+
+	order := make([]int, n)
+	lots := make([]float64, n)
+	for i := range order {
+		order[i] = i
+		lots[i] = rng.Float64()
+	}
+	sort.Slice(order, func(a, b int) bool {
+		i, j := order[a], order[b]
+		switch {
+		case points[i] != points[j]:
+			return points[i] > points[j]
+		case diff[i] != diff[j]:
+			return diff[i] > diff[j]
+		case scored[i] != scored[j]:
+			return scored[i] > scored[j]
+		default:
+			return lots[i] < lots[j]
+		}
+	})
+	for rank, team := range order {
+		t.ranks[team][rank]++
+		t.points[team][points[team]]++
+	}
+}
+
+// result turns the tally of runs simulations into a Result.
+func (s *Simulator) result(runs int, t *tally) *Result {
+	res := &Result{Runs: runs, Teams: make([]TeamResult, len(s.Standings))}
+	// Zones belong to ranks, not to the teams currently holding them.
+	zones := make([]string, len(s.Standings))
+	for i, row := range s.Standings {
+		rank := row.Rank - 1
+		if rank < 0 || rank >= len(zones) {
+			rank = i
+		}
+		zones[rank] = row.Description
+	}
+	for i, row := range s.Standings {
+		team := TeamResult{
+			Team:              row.Team,
+			RankProbabilities: make([]float64, len(s.Standings)),
+			Points:            make(map[int]float64, len(t.points[i])),
+			Zones:             make(map[string]float64),
+		}
+		for rank, n := range t.ranks[i] {
+			p := float64(n) / float64(runs)
+			team.RankProbabilities[rank] = p
+			team.ExpectedRank += p * float64(rank+1)
+			if zone := zones[rank]; zone != "" && n > 0 {
+				team.Zones[zone] += p
+			}
+		}
+		// Sum in integers: map order would otherwise change the rounding.
+		var totalPoints int
+		for points, n := range t.points[i] {
+			team.Points[points] = float64(n) / float64(runs)
+			totalPoints += points * n
+		}
+		team.ExpectedPoints = float64(totalPoints) / float64(runs)
+		res.Teams[i] = team
+	}
+	return res
+}
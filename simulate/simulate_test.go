@@ -0,0 +1,134 @@
+package simulate_test
+
+import (
+	"context"
+	"math/rand/v2"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/0ffsideCompass/api-football-go-client/internal/fixturetest"
+	"github.com/0ffsideCompass/api-football-go-client/models"
+	"github.com/0ffsideCompass/api-football-go-client/simulate"
+	"github.com/0ffsideCompass/api-football-go-client/table"
+)
+
+func simulationStandings() []models.StandingRow {
+	row := func(rank int, team models.TeamRef, points, diff int, description string) models.StandingRow {
+		return models.StandingRow{Rank: rank, Team: team, Points: points, GoalsDiff: diff, Description: description}
+	}
+	return []models.StandingRow{
+		row(1, fixturetest.TeamA, 30, 20, "Promotion - Champions League (Group Stage)"),
+		row(2, fixturetest.TeamB, 20, 5, ""),
+		row(3, fixturetest.TeamC, 19, 6, ""),
+		row(4, fixturetest.TeamD, 0, -31, "Relegation - Championship"),
+	}
+}
+
+func remainingFixtures() []models.Fixture {
+	return []models.Fixture{
+		fixturetest.Upcoming(11, 20, "Round 10", fixturetest.TeamB, fixturetest.TeamC),
+		fixturetest.Upcoming(12, 20, "Round 10", fixturetest.TeamA, fixturetest.TeamD),
+		fixturetest.Fixture(13, 27, "Round 11", fixturetest.TeamC, fixturetest.TeamA, 0, 0, models.StatusPostponed),
+		// Already played: skipped.
+		fixturetest.Fixture(14, 13, "Round 9", fixturetest.TeamB, fixturetest.TeamD, 1, 0, models.StatusFinished),
+	}
+}
+
+func TestSimulateSeason(t *testing.T) {
+	model := simulate.Probabilities{
+		11: {Home: 45, Draw: 25, Away: 30},
+		12: {Home: 0.8, Draw: 0.15, Away: 0.05},
+		13: {Home: 0.3, Draw: 0.3, Away: 0.4},
+	}
+	sim := &simulate.Simulator{
+		Standings: simulationStandings(),
+		Fixtures:  remainingFixtures(),
+		Model:     model,
+		Runs:      4000,
+		Seed:      7,
+		Workers:   4,
+	}
+	result, err := sim.Run(context.Background())
+	assert.NoError(t, err)
+	assert.Equal(t, 4000, result.Runs)
+	assert.Len(t, result.Teams, 4)
+
+	leader := result.Teams[0]
+	assert.Equal(t, fixturetest.TeamA, leader.Team)
+	assert.Equal(t, 1.0, leader.RankProbabilities[0], "a 10-point lead with two games left is safe")
+	assert.Equal(t, map[string]float64{"Promotion - Champions League (Group Stage)": 1}, leader.Zones)
+	assert.Equal(t, 1.0, result.Teams[3].Zones["Relegation - Championship"])
+	assert.Equal(t, 4.0, result.Teams[3].ExpectedRank)
+
+	// B and C share second place, and B's home game against C decides most
+	// of it.
+	second := result.Teams[1].RankProbabilities[1]
+	assert.Greater(t, second, 0.3)
+	assert.Less(t, second, 0.7)
+	assert.InDelta(t, 1, second+result.Teams[2].RankProbabilities[1], 1e-9)
+
+	var total float64
+	for points, p := range result.Teams[1].Points {
+		assert.Contains(t, []int{20, 21, 23}, points)
+		total += p
+	}
+	assert.InDelta(t, 1, total, 1e-9)
+	assert.InDelta(t, 20*0.3+21*0.25+23*0.45, result.Teams[1].ExpectedPoints, 0.05)
+
+	// The seed alone determines the result.
+	sim.Workers = 1
+	again, err := sim.Run(context.Background())
+	assert.NoError(t, err)
+	assert.Equal(t, result, again)
+}
+
+// fixedScores is a ScoreModel where the home side always wins 3-0.
+type fixedScores struct{ simulate.Probabilities }
+
+func (fixedScores) ScoreSampler(models.Fixture) (simulate.ScoreSampler, error) {
+	return func(*rand.Rand) (int, int) { return 3, 0 }, nil
+}
+
+func TestSimulateScoreModel(t *testing.T) {
+	standings := simulationStandings()
+	standings[1].Points, standings[2].Points = 19, 19
+	fixtures := remainingFixtures()[:2]
+	sim := &simulate.Simulator{
+		Standings: standings,
+		Fixtures:  []models.Fixture{fixturetest.Upcoming(15, 20, "Round 10", fixturetest.TeamC, fixturetest.TeamD), fixtures[1]},
+		Model:     fixedScores{simulate.Probabilities{15: {Home: 1}, 12: {Home: 1}}},
+		Runs:      10,
+		Points:    table.TwoPoints,
+	}
+	result, err := sim.Run(context.Background())
+	assert.NoError(t, err)
+	// C wins 3-0 for 2 points: 21, ahead of B's 19.
+	assert.Equal(t, 1.0, result.Teams[2].RankProbabilities[1])
+	assert.Equal(t, map[int]float64{21: 1}, result.Teams[2].Points)
+	assert.Equal(t, map[int]float64{32: 1}, result.Teams[0].Points)
+}
+
+func TestSimulateErrors(t *testing.T) {
+	sim := &simulate.Simulator{Standings: simulationStandings(), Fixtures: remainingFixtures(), Model: simulate.Probabilities{}}
+	_, err := sim.Run(context.Background())
+	assert.EqualError(t, err, "error getting outcome probabilities: no probabilities for fixture 11")
+
+	sim.Model = simulate.ModelFunc(func(models.Fixture) (simulate.Outcome, error) { return simulate.Outcome{}, nil })
+	_, err = sim.Run(context.Background())
+	assert.ErrorContains(t, err, "fixture 11: invalid outcome probabilities")
+
+	sim.Model = simulate.ModelFunc(func(models.Fixture) (simulate.Outcome, error) { return simulate.Outcome{Draw: 1}, nil })
+	sim.Standings = simulationStandings()[:3]
+	_, err = sim.Run(context.Background())
+	assert.EqualError(t, err, "fixture 12: team 4 is not in the standings")
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	sim = &simulate.Simulator{Standings: simulationStandings(), Model: simulate.Probabilities{}}
+	_, err = sim.Run(ctx)
+	assert.ErrorIs(t, err, context.Canceled)
+
+	_, err = simulate.New(&models.StandingsResponse{}, nil, sim.Model)
+	assert.EqualError(t, err, "no standings in response")
+}
@@ -1,6 +1,7 @@
 package client
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 
@@ -15,16 +16,32 @@ const (
 /*
 	- season: (Type: integer) (Required) 4 characters YYYY
 	  The season of the standings. Value format: 2019
-	- league: (Type: integer) (Required)
+	- league: (Type: integer)
 	  The ID of the league. Value format: 43
 	- team: (Type: integer)
 	  The ID of the team. Value format: 85
+
+	At least one of league or team must be passed
 */
 func (c *Client) Standings(
 	params map[string]any,
 ) (*models.StandingsResponse, error) {
+	return c.StandingsContext(context.Background(), params)
+}
+
+// StandingsContext is like Standings but with a context.
+func (c *Client) StandingsContext(
+	ctx context.Context,
+	params map[string]any,
+) (*models.StandingsResponse, error) {
+	// Validate the parameters
+	if err := c.validateParams(standingsEndpoint, params); err != nil {
+		return nil, err
+	}
+
 	endpointURL := fmt.Sprintf("%s%s", c.Domain, standingsEndpoint)
 	body, err := c.get(
+		ctx,
 		c.buildURL(
 			endpointURL,
 			params,
@@ -43,3 +60,8 @@ func (c *Client) Standings(
 	}
 	return &resp, nil
 }
+
+// StandingsWith is like StandingsContext but takes typed parameters.
+func (c *Client) StandingsWith(ctx context.Context, params StandingsParams) (*models.StandingsResponse, error) {
+	return c.StandingsContext(ctx, params.Encode())
+}
@@ -75,16 +75,12 @@ func TestStandings(t *testing.T) {
 			errorContains: "error unmarshalling standings response",
 		},
 		{
-			name:   "standings request with nil params",
-			params: nil,
-			responseBody: `{
-				"response": [],
-				"errors": [],
-				"results": 0,
-				"paging": {"current": 1, "total": 1}
-			}`,
-			statusCode:  http.StatusOK,
-			expectError: false,
+			name:          "standings request with nil params",
+			params:        nil,
+			responseBody:  ``,
+			statusCode:    http.StatusOK,
+			expectError:   true,
+			errorContains: "'season' is required",
 		},
 		{
 			name:          "standings request with 401 unauthorized",
@@ -88,3 +88,78 @@ func TestFlexStringRejectsInvalidJSON(t *testing.T) {
 	var f models.FlexString
 	assert.Error(t, f.UnmarshalJSON([]byte(`{"not": "a scalar"}`)))
 }
+
+func TestTeamMatchStats(t *testing.T) {
+	body := `{
+		"get": "fixtures/statistics", "parameters": {"fixture": "1035037"}, "errors": [], "results": 1,
+		"paging": {"current": 1, "total": 1},
+		"response": [{
+			"team": {"id": 33, "name": "Manchester United", "logo": "l"},
+			"statistics": [
+				{"type": "Shots on Goal", "value": 6},
+				{"type": "Shots off Goal", "value": 4},
+				{"type": "Total Shots", "value": 15},
+				{"type": "Blocked Shots", "value": 5},
+				{"type": "Shots insidebox", "value": 11},
+				{"type": "Shots outsidebox", "value": 4},
+				{"type": "Fouls", "value": 9},
+				{"type": "Corner Kicks", "value": 7},
+				{"type": "Offsides", "value": null},
+				{"type": "Ball Possession", "value": "55%"},
+				{"type": "Yellow Cards", "value": 2},
+				{"type": "Red Cards", "value": null},
+				{"type": "Goalkeeper Saves", "value": 3},
+				{"type": "Total passes", "value": 512},
+				{"type": "Passes accurate", "value": 441},
+				{"type": "Passes %", "value": "86%"},
+				{"type": "expected_goals", "value": "1.74"},
+				{"type": "Big Chances", "value": 3}
+			]
+		}]
+	}`
+	resp, err := newTestClient(t, body).FixtureStatistics(map[string]any{"fixture": 1035037})
+	assert.NoError(t, err)
+
+	stats := resp.Response[0].MatchStats()
+	assert.Equal(t, models.TeamMatchStats{
+		ShotsOnGoal:     6,
+		ShotsOffGoal:    4,
+		TotalShots:      15,
+		BlockedShots:    5,
+		ShotsInsideBox:  11,
+		ShotsOutBox:     4,
+		Fouls:           9,
+		CornerKicks:     7,
+		Possession:      55,
+		YellowCards:     2,
+		GoalkeeperSaves: 3,
+		TotalPasses:     512,
+		PassesAccurate:  441,
+		PassesPercent:   86,
+		ExpectedGoals:   1.74,
+		Unknown:         []models.Statistic{{Type: "Big Chances", Value: "3"}},
+	}, stats)
+}
+
+func TestTeamMatchStatsMissingAndInvalid(t *testing.T) {
+	stats := models.NewTeamMatchStats([]models.Statistic{{Type: "Total Shots", Value: "8"}})
+	assert.Equal(t, models.TeamMatchStats{TotalShots: 8}, stats)
+
+	// Bad values are set aside; the rest of the statistics are still filled.
+	stats = models.NewTeamMatchStats([]models.Statistic{
+		{Type: "Shots on Goal", Value: "6"},
+		{Type: "Ball Possession", Value: "n/a"},
+		{Type: "Fouls", Value: "9.5"},
+		{Type: "Passes %", Value: "86%"},
+		{Type: "Corner Kicks", Value: "7"},
+	})
+	assert.Equal(t, models.TeamMatchStats{
+		ShotsOnGoal:   6,
+		CornerKicks:   7,
+		PassesPercent: 86,
+		Unknown: []models.Statistic{
+			{Type: "Ball Possession", Value: "n/a"},
+			{Type: "Fouls", Value: "9.5"},
+		},
+	}, stats)
+}
@@ -0,0 +1,83 @@
+package table
+
+import (
+	"sort"
+	"strconv"
+
+	"github.com/0ffsideCompass/api-football-go-client/models"
+)
+
+// Mismatch is a difference between a computed standings row and the
+// official one.
+type Mismatch struct {
+	Team models.TeamRef
+	// Field names the differing value, such as "points", "all.played" or
+	// "home.goals.for". It is "team" when the team is missing from one of
+	// the tables.
+	Field string
+	Got   string
+	Want  string
+}
+
+// Compare checks computed standings against official ones, such as a table
+// of a /standings response, and returns their differences ordered by team
+// ID. Rank, points, goal difference and the all, home and away records are
+// compared; form strings are not, since providers differ in how many results
+// they show.
+func Compare(computed, official []models.StandingRow) []Mismatch {
+	want := make(map[int]models.StandingRow, len(official))
+	for _, row := range official {
+		want[row.Team.ID] = row
+	}
+	got := make(map[int]models.StandingRow, len(computed))
+	for _, row := range computed {
+		got[row.Team.ID] = row
+	}
+
+	var mismatches []Mismatch
+	for id, g := range got {
+		w, ok := want[id]
+		if !ok {
+			mismatches = append(mismatches, Mismatch{Team: g.Team, Field: "team", Got: "present", Want: "missing"})
+			continue
+		}
+		diff := func(field string, got, want int) {
+			if got != want {
+				mismatches = append(mismatches, Mismatch{
+					Team:  g.Team,
+					Field: field,
+					Got:   strconv.Itoa(got),
+					Want:  strconv.Itoa(want),
+				})
+			}
+		}
+		diff("rank", g.Rank, w.Rank)
+		diff("points", g.Points, w.Points)
+		diff("goalsDiff", g.GoalsDiff, w.GoalsDiff)
+		for _, venue := range []struct {
+			name      string
+			got, want models.StandingRecord
+		}{
+			{"all", g.All, w.All},
+			{"home", g.Home, w.Home},
+			{"away", g.Away, w.Away},
+		} {
+			diff(venue.name+".played", venue.got.Played, venue.want.Played)
+			diff(venue.name+".win", venue.got.Win, venue.want.Win)
+			diff(venue.name+".draw", venue.got.Draw, venue.want.Draw)
+			diff(venue.name+".lose", venue.got.Lose, venue.want.Lose)
+			diff(venue.name+".goals.for", venue.got.Goals.For, venue.want.Goals.For)
+			diff(venue.name+".goals.against", venue.got.Goals.Against, venue.want.Goals.Against)
+		}
+	}
+	for id, w := range want {
+		if _, ok := got[id]; !ok {
+			mismatches = append(mismatches, Mismatch{Team: w.Team, Field: "team", Got: "missing", Want: "present"})
+		}
+	}
+
+	sort.SliceStable(mismatches, func(i, j int) bool {
+		return mismatches[i].Team.ID < mismatches[j].Team.ID
+	})
+	return mismatches
+}
@@ -0,0 +1,26 @@
+package table_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/0ffsideCompass/api-football-go-client/internal/fixturetest"
+	"github.com/0ffsideCompass/api-football-go-client/models"
+	"github.com/0ffsideCompass/api-football-go-client/table"
+)
+
+func TestTableCompare(t *testing.T) {
+	computed := table.New(seasonFixtures(), table.DefaultRules).Standings()
+	assert.Empty(t, table.Compare(computed, computed))
+
+	official := append([]models.StandingRow(nil), computed[:3]...)
+	official[0].Points = 4
+	official[0].Home.Goals.For = 2
+
+	assert.Equal(t, []table.Mismatch{
+		{Team: fixturetest.TeamA, Field: "points", Got: "7", Want: "4"},
+		{Team: fixturetest.TeamA, Field: "home.goals.for", Got: "3", Want: "2"},
+		{Team: fixturetest.TeamD, Field: "team", Got: "present", Want: "missing"},
+	}, table.Compare(computed, official))
+}
@@ -0,0 +1,238 @@
+package table
+
+import (
+	"sort"
+
+	"github.com/0ffsideCompass/api-football-go-client/models"
+)
+
+// Tiebreaker is a criterion separating teams level on points.
+type Tiebreaker int
+
+// Tiebreakers. The head-to-head criteria only count the matches between the
+// teams still level when they are applied, so with three or more teams they
+// form a mini-league.
+const (
+	// GoalDifference ranks by goal difference over all matches.
+	GoalDifference Tiebreaker = iota + 1
+	// GoalsScored ranks by goals scored over all matches.
+	GoalsScored
+	// AwayGoals ranks by goals scored in away matches.
+	AwayGoals
+	// Wins ranks by number of wins.
+	Wins
+	// HeadToHead ranks by points won in the matches between the tied teams.
+	HeadToHead
+	// HeadToHeadGoalDifference ranks by goal difference in the matches
+	// between the tied teams.
+	HeadToHeadGoalDifference
+	// HeadToHeadGoalsScored ranks by goals scored in the matches between
+	// the tied teams.
+	HeadToHeadGoalsScored
+	// HeadToHeadAwayGoals ranks by away goals scored in the matches between
+	// the tied teams.
+	HeadToHeadAwayGoals
+)
+
+// String returns the name of the tiebreaker.
+func (tb Tiebreaker) String() string {
+	switch tb {
+	case GoalDifference:
+		return "goal difference"
+	case GoalsScored:
+		return "goals scored"
+	case AwayGoals:
+		return "away goals"
+	case Wins:
+		return "wins"
+	case HeadToHead:
+		return "head-to-head points"
+	case HeadToHeadGoalDifference:
+		return "head-to-head goal difference"
+	case HeadToHeadGoalsScored:
+		return "head-to-head goals scored"
+	case HeadToHeadAwayGoals:
+		return "head-to-head away goals"
+	default:
+		return "unknown tiebreaker"
+	}
+}
+
+// compute returns the standings after results.
+func (t *Table) compute(results []Result) []models.StandingRow {
+	points := t.rules.Points
+	if points == (PointsSystem{}) {
+		points = ThreePoints
+	}
+	formLength := t.rules.FormLength
+	if formLength <= 0 {
+		formLength = defaultFormLength
+	}
+
+	rows := make(map[int]*models.StandingRow, len(t.teams))
+	form := make(map[int][]byte, len(t.teams))
+	for id, team := range t.teams {
+		rows[id] = &models.StandingRow{Team: team, Points: t.rules.Adjustments[id]}
+	}
+	for _, r := range results {
+		home, away := rows[r.Home.ID], rows[r.Away.ID]
+		homeResult := record(&home.All, &home.Home, r.HomeGoals, r.AwayGoals)
+		awayResult := record(&away.All, &away.Away, r.AwayGoals, r.HomeGoals)
+		home.Points += points.award(homeResult)
+		away.Points += points.award(awayResult)
+		form[r.Home.ID] = append(form[r.Home.ID], homeResult)
+		form[r.Away.ID] = append(form[r.Away.ID], awayResult)
+	}
+
+	ordered := make([]*models.StandingRow, 0, len(rows))
+	for id, row := range rows {
+		row.GoalsDiff = row.All.Goals.For - row.All.Goals.Against
+		recent := form[id][max(0, len(form[id])-formLength):]
+		row.Form = string(recent)
+		ordered = append(ordered, row)
+	}
+	t.rank(ordered, results, points)
+
+	out := make([]models.StandingRow, len(ordered))
+	for i, row := range ordered {
+		row.Rank = i + 1
+		out[i] = *row
+	}
+	return out
+}
+
+// record adds a match to a team's overall and home or away records, and
+// returns its result: 'W', 'D' or 'L'.
+func record(all, venue *models.StandingRecord, scored, conceded int) byte {
+	result := byte('D')
+	switch {
+	case scored > conceded:
+		result = 'W'
+	case scored < conceded:
+		result = 'L'
+	}
+	for _, rec := range []*models.StandingRecord{all, venue} {
+		rec.Played++
+		rec.Goals.For += scored
+		rec.Goals.Against += conceded
+		switch result {
+		case 'W':
+			rec.Win++
+		case 'D':
+			rec.Draw++
+		default:
+			rec.Lose++
+		}
+	}
+	return result
+}
+
+// award returns the points for a result.
+func (p PointsSystem) award(result byte) int {
+	switch result {
+	case 'W':
+		return p.Win
+	case 'D':
+		return p.Draw
+	default:
+		return p.Loss
+	}
+}
+
+// rank orders rows by points, then the tiebreakers, then team name and ID.
+func (t *Table) rank(rows []*models.StandingRow, results []Result, points PointsSystem) {
+	sort.SliceStable(rows, func(i, j int) bool {
+		a, b := rows[i], rows[j]
+		if a.Points != b.Points {
+			return a.Points > b.Points
+		}
+		if a.Team.Name != b.Team.Name {
+			return a.Team.Name < b.Team.Name
+		}
+		return a.Team.ID < b.Team.ID
+	})
+	// Break ties within each group of teams level on points.
+	for start := 0; start < len(rows); {
+		end := start + 1
+		for end < len(rows) && rows[end].Points == rows[start].Points {
+			end++
+		}
+		breakTies(rows[start:end], t.rules.Tiebreakers, results, points)
+		start = end
+	}
+}
+
+// breakTies orders a group of tied rows by the first tiebreaker, then
+// applies the remaining ones to each group still tied. Rows that remain
+// level keep their order.
+func breakTies(rows []*models.StandingRow, tiebreakers []Tiebreaker, results []Result, points PointsSystem) {
+	if len(rows) < 2 || len(tiebreakers) == 0 {
+		return
+	}
+	keys := tiebreakers[0].keys(rows, results, points)
+	sort.SliceStable(rows, func(i, j int) bool {
+		return keys[rows[i].Team.ID] > keys[rows[j].Team.ID]
+	})
+	for start := 0; start < len(rows); {
+		end := start + 1
+		for end < len(rows) && keys[rows[end].Team.ID] == keys[rows[start].Team.ID] {
+			end++
+		}
+		breakTies(rows[start:end], tiebreakers[1:], results, points)
+		start = end
+	}
+}
+
+// keys returns the value of the tiebreaker for each row, keyed by team ID.
+// Higher values rank first.
+func (tb Tiebreaker) keys(rows []*models.StandingRow, results []Result, points PointsSystem) map[int]int {
+	keys := make(map[int]int, len(rows))
+	switch tb {
+	case GoalDifference:
+		for _, row := range rows {
+			keys[row.Team.ID] = row.GoalsDiff
+		}
+	case GoalsScored:
+		for _, row := range rows {
+			keys[row.Team.ID] = row.All.Goals.For
+		}
+	case AwayGoals:
+		for _, row := range rows {
+			keys[row.Team.ID] = row.Away.Goals.For
+		}
+	case Wins:
+		for _, row := range rows {
+			keys[row.Team.ID] = row.All.Win
+		}
+	case HeadToHead, HeadToHeadGoalDifference, HeadToHeadGoalsScored, HeadToHeadAwayGoals:
+		tied := make(map[int]bool, len(rows))
+		for _, row := range rows {
+			tied[row.Team.ID] = true
+		}
+		for _, r := range results {
+			if !tied[r.Home.ID] || !tied[r.Away.ID] {
+				continue
+			}
+			switch tb {
+			case HeadToHead:
+				var homeResult, awayResult byte = 'D', 'D'
+				if r.HomeGoals > r.AwayGoals {
+					homeResult, awayResult = 'W', 'L'
+				} else if r.HomeGoals < r.AwayGoals {
+					homeResult, awayResult = 'L', 'W'
+				}
+				keys[r.Home.ID] += points.award(homeResult)
+				keys[r.Away.ID] += points.award(awayResult)
+			case HeadToHeadGoalDifference:
+				keys[r.Home.ID] += r.HomeGoals - r.AwayGoals
+				keys[r.Away.ID] += r.AwayGoals - r.HomeGoals
+			case HeadToHeadGoalsScored:
+				keys[r.Home.ID] += r.HomeGoals
+				keys[r.Away.ID] += r.AwayGoals
+			case HeadToHeadAwayGoals:
+				keys[r.Away.ID] += r.AwayGoals
+			}
+		}
+	}
+	return keys
+}
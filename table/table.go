@@ -0,0 +1,290 @@
+// Package table computes league standings from fixture results, so a table
+// can be rebuilt as of any date or round, projected with hypothetical
+// results, and checked against the /standings endpoint.
+//
+//	resp, err := cli.Fixture(map[string]any{"league": 39, "season": 2023})
+//	// ...
+//	t := table.FromResponse(resp, table.DefaultRules)
+//	rows, err := t.AtRound("Regular Season - 10")
+//
+// Rows are models.StandingRow values, the type the /standings endpoint
+// returns, with Rank, Points, GoalsDiff, Form and the all, home and away
+// records filled in.
+package table
+
+import (
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/0ffsideCompass/api-football-go-client/models"
+)
+
+// PointsSystem is the number of points awarded for each result.
+type PointsSystem struct {
+	Win, Draw, Loss int
+}
+
+// Common points systems.
+var (
+	// ThreePoints awards 3 points for a win and 1 for a draw.
+	ThreePoints = PointsSystem{Win: 3, Draw: 1}
+	// TwoPoints awards 2 points for a win and 1 for a draw.
+	TwoPoints = PointsSystem{Win: 2, Draw: 1}
+)
+
+// defaultFormLength is the number of results in a form string.
+const defaultFormLength = 5
+
+// Rules configures how standings are computed.
+type Rules struct {
+	// Points is the points system. The zero value means ThreePoints.
+	Points PointsSystem
+	// Tiebreakers separate teams level on points, applied in order. Teams
+	// still level are ordered by name.
+	Tiebreakers []Tiebreaker
+	// Adjustments adds points to teams, keyed by team ID: negative for
+	// deductions.
+	Adjustments map[int]int
+	// FormLength is the number of results in a form string. Zero means 5.
+	FormLength int
+}
+
+// DefaultRules are the rules of most domestic leagues, including the
+// Premier League: 3 points for a win, then goal difference, goals scored and
+// head-to-head.
+var DefaultRules = Rules{
+	Points:      ThreePoints,
+	Tiebreakers: []Tiebreaker{GoalDifference, GoalsScored, HeadToHead},
+}
+
+// Result is the result of a fixture.
+type Result struct {
+	FixtureID int
+	Date      time.Time
+	Round     string
+	Home      models.TeamRef
+	Away      models.TeamRef
+	HomeGoals int
+	AwayGoals int
+}
+
+// Table holds the fixtures of a league season and computes its standings.
+// A Table is immutable and safe for concurrent use.
+type Table struct {
+	rules   Rules
+	teams   map[int]models.TeamRef
+	results []Result
+	pending []models.Fixture
+	rounds  map[string]time.Time
+}
+
+// New returns a Table for fixtures, which should be the fixtures of a single
+// league season. Fixtures with a result (see models.FixtureStatus.HasResult)
+// count towards the standings, cancelled and abandoned ones are ignored, and
+// the others are pending. Every team appearing in a fixture has a row.
+//
+// The API often lists awarded fixtures (AWD, WO) without goals, which
+// decode as 0-0. Such a fixture is scored from its winner flags, as a
+// forfeit won 3-0, and ignored when neither team is flagged the winner,
+// rather than counted as a goalless draw.
+func New(fixtures []models.Fixture, rules Rules) *Table {
+	t := &Table{
+		rules:  rules,
+		teams:  make(map[int]models.TeamRef),
+		rounds: make(map[string]time.Time),
+	}
+	for _, f := range fixtures {
+		t.addTeams(f.Teams.Home.TeamRef, f.Teams.Away.TeamRef)
+		t.addRound(f.League.Round, f.Fixture.Date)
+		status := f.Fixture.Status.Short
+		switch {
+		case status.HasResult():
+			if r, ok := resultOf(f); ok {
+				t.results = append(t.results, r)
+			}
+		case status.IsCancelled():
+		default:
+			t.pending = append(t.pending, f)
+		}
+	}
+	sortResults(t.results)
+	return t
+}
+
+// FromResponse returns a Table for the fixtures of a /fixtures response.
+func FromResponse(resp *models.FixturesResponse, rules Rules) *Table {
+	fixtures := make([]models.Fixture, len(resp.Response))
+	for i, f := range resp.Response {
+		fixtures[i] = f.Summary()
+	}
+	return New(fixtures, rules)
+}
+
+// forfeitGoals is the score of the winner of an awarded fixture listed
+// without goals.
+const forfeitGoals = 3
+
+// resultOf returns the result of a fixture with a result. It reports false
+// for an awarded fixture listed without goals or winner.
+func resultOf(f models.Fixture) (Result, bool) {
+	r := Result{
+		FixtureID: f.Fixture.ID,
+		Date:      f.Fixture.Date,
+		Round:     f.League.Round,
+		Home:      f.Teams.Home.TeamRef,
+		Away:      f.Teams.Away.TeamRef,
+		HomeGoals: f.Goals.Home,
+		AwayGoals: f.Goals.Away,
+	}
+	if f.Fixture.Status.Short.IsFinished() || f.Goals != (models.Goals{}) {
+		return r, true
+	}
+	switch {
+	case f.Teams.Home.Winner:
+		r.HomeGoals = forfeitGoals
+	case f.Teams.Away.Winner:
+		r.AwayGoals = forfeitGoals
+	default:
+		return r, false
+	}
+	return r, true
+}
+
+func (t *Table) addTeams(teams ...models.TeamRef) {
+	for _, team := range teams {
+		if _, ok := t.teams[team.ID]; !ok {
+			t.teams[team.ID] = team
+		}
+	}
+}
+
+// addRound records the earliest date of a round.
+func (t *Table) addRound(round string, date time.Time) {
+	if round == "" {
+		return
+	}
+	if first, ok := t.rounds[round]; !ok || date.Before(first) {
+		t.rounds[round] = date
+	}
+}
+
+// sortResults orders results by date, then fixture ID.
+func sortResults(results []Result) {
+	sort.SliceStable(results, func(i, j int) bool {
+		if !results[i].Date.Equal(results[j].Date) {
+			return results[i].Date.Before(results[j].Date)
+		}
+		return results[i].FixtureID < results[j].FixtureID
+	})
+}
+
+// Results returns the results counting towards the standings, oldest first.
+func (t *Table) Results() []Result {
+	return append([]Result(nil), t.results...)
+}
+
+// Remaining returns the fixtures still to be played, in the order given to
+// New.
+func (t *Table) Remaining() []models.Fixture {
+	return append([]models.Fixture(nil), t.pending...)
+}
+
+// Rounds returns the rounds of the season, ordered by the date of their
+// first fixture.
+func (t *Table) Rounds() []string {
+	rounds := make([]string, 0, len(t.rounds))
+	for round := range t.rounds {
+		rounds = append(rounds, round)
+	}
+	sort.Slice(rounds, func(i, j int) bool {
+		a, b := t.rounds[rounds[i]], t.rounds[rounds[j]]
+		if !a.Equal(b) {
+			return a.Before(b)
+		}
+		return rounds[i] < rounds[j]
+	})
+	return rounds
+}
+
+// With returns a copy of t with the given results added, for what-if
+// scenarios. A result whose FixtureID matches a pending fixture or an
+// existing result takes its place.
+func (t *Table) With(results ...Result) *Table {
+	next := &Table{
+		rules:   t.rules,
+		teams:   make(map[int]models.TeamRef, len(t.teams)),
+		results: make([]Result, 0, len(t.results)+len(results)),
+		rounds:  make(map[string]time.Time, len(t.rounds)),
+	}
+	for id, team := range t.teams {
+		next.teams[id] = team
+	}
+	for round, date := range t.rounds {
+		next.rounds[round] = date
+	}
+
+	replaced := make(map[int]bool, len(results))
+	for _, r := range results {
+		if r.FixtureID != 0 {
+			replaced[r.FixtureID] = true
+		}
+	}
+	for _, r := range t.results {
+		if !replaced[r.FixtureID] {
+			next.results = append(next.results, r)
+		}
+	}
+	for _, f := range t.pending {
+		if !replaced[f.Fixture.ID] {
+			next.pending = append(next.pending, f)
+		}
+	}
+	for _, r := range results {
+		next.addTeams(r.Home, r.Away)
+		next.addRound(r.Round, r.Date)
+		next.results = append(next.results, r)
+	}
+	sortResults(next.results)
+	return next
+}
+
+// Standings returns the standings after every result.
+func (t *Table) Standings() []models.StandingRow {
+	return t.compute(t.results)
+}
+
+// AtDate returns the standings after the results played at or before date.
+func (t *Table) AtDate(date time.Time) []models.StandingRow {
+	var results []Result
+	for _, r := range t.results {
+		if !r.Date.After(date) {
+			results = append(results, r)
+		}
+	}
+	return t.compute(results)
+}
+
+// AtRound returns the standings after the results of round and every round
+// before it, in the order of Rounds. Fixtures of those rounds that were
+// postponed and played later are included.
+func (t *Table) AtRound(round string) ([]models.StandingRow, error) {
+	if _, ok := t.rounds[round]; !ok {
+		return nil, fmt.Errorf("unknown round %q", round)
+	}
+	included := make(map[string]bool)
+	for _, r := range t.Rounds() {
+		included[r] = true
+		if r == round {
+			break
+		}
+	}
+
+	var results []Result
+	for _, r := range t.results {
+		if included[r.Round] {
+			results = append(results, r)
+		}
+	}
+	return t.compute(results), nil
+}
@@ -0,0 +1,150 @@
+package table_test
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/0ffsideCompass/api-football-go-client/internal/fixturetest"
+	"github.com/0ffsideCompass/api-football-go-client/models"
+	"github.com/0ffsideCompass/api-football-go-client/table"
+)
+
+func seasonFixtures() []models.Fixture {
+	return []models.Fixture{
+		fixturetest.Fixture(1, 1, "Round 1", fixturetest.TeamA, fixturetest.TeamB, 2, 0, models.StatusFinished),
+		fixturetest.Fixture(2, 1, "Round 1", fixturetest.TeamC, fixturetest.TeamD, 1, 1, models.StatusFinished),
+		fixturetest.Fixture(3, 8, "Round 2", fixturetest.TeamB, fixturetest.TeamC, 3, 1, models.StatusFinished),
+		fixturetest.Fixture(4, 8, "Round 2", fixturetest.TeamD, fixturetest.TeamA, 0, 1, models.StatusFinishedAfterExtra),
+		fixturetest.Fixture(5, 15, "Round 3", fixturetest.TeamA, fixturetest.TeamC, 1, 1, models.StatusFinished),
+		fixturetest.Upcoming(6, 15, "Round 3", fixturetest.TeamB, fixturetest.TeamD),
+		fixturetest.Fixture(7, 15, "Round 3", fixturetest.TeamB, fixturetest.TeamD, 0, 0, models.StatusCancelled),
+	}
+}
+
+func teamOrder(rows []models.StandingRow) []string {
+	var names []string
+	for _, row := range rows {
+		names = append(names, row.Team.Name)
+	}
+	return names
+}
+
+func TestTableStandings(t *testing.T) {
+	tbl := table.New(seasonFixtures(), table.DefaultRules)
+
+	rows := tbl.Standings()
+	assert.Equal(t, []string{"A", "B", "C", "D"}, teamOrder(rows))
+	a := rows[0]
+	assert.Equal(t, 1, a.Rank)
+	assert.Equal(t, 7, a.Points)
+	assert.Equal(t, 3, a.GoalsDiff)
+	assert.Equal(t, "WWD", a.Form)
+	assert.Equal(t, models.StandingRecord{Played: 3, Win: 2, Draw: 1, Goals: models.GoalsForAgainst{For: 4, Against: 1}}, a.All)
+	assert.Equal(t, models.StandingRecord{Played: 2, Win: 1, Draw: 1, Goals: models.GoalsForAgainst{For: 3, Against: 1}}, a.Home)
+	assert.Equal(t, models.StandingRecord{Played: 1, Win: 1, Goals: models.GoalsForAgainst{For: 1}}, a.Away)
+
+	assert.Equal(t, []string{"Round 1", "Round 2", "Round 3"}, tbl.Rounds())
+	assert.Len(t, tbl.Results(), 5)
+	assert.Len(t, tbl.Remaining(), 1, "cancelled fixtures are dropped")
+
+	rows, err := tbl.AtRound("Round 1")
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"A", "C", "D", "B"}, teamOrder(rows))
+	assert.Equal(t, 1, rows[3].All.Played)
+	_, err = tbl.AtRound("Round 9")
+	assert.EqualError(t, err, `unknown round "Round 9"`)
+
+	rows = tbl.AtDate(time.Date(2023, 8, 8, 23, 0, 0, 0, time.UTC))
+	assert.Equal(t, 6, rows[0].Points)
+	assert.Equal(t, 2, rows[0].All.Played)
+}
+
+func TestTableAwardedWithoutGoals(t *testing.T) {
+	walkOver := fixturetest.Fixture(8, 22, "Round 4", fixturetest.TeamC, fixturetest.TeamA, 0, 0, models.StatusWalkOver)
+	walkOver.Teams.Away.Winner = true
+	awarded := fixturetest.Fixture(9, 22, "Round 4", fixturetest.TeamD, fixturetest.TeamB, 2, 0, models.StatusTechnicalLoss)
+	unknown := fixturetest.Fixture(10, 29, "Round 5", fixturetest.TeamD, fixturetest.TeamC, 0, 0, models.StatusTechnicalLoss)
+	tbl := table.New(append(seasonFixtures(), walkOver, awarded, unknown), table.DefaultRules)
+
+	results := tbl.Results()
+	assert.Len(t, results, 7, "awarded fixtures without goals or winner are ignored")
+	assert.Equal(t, 8, results[5].FixtureID)
+	assert.Equal(t, []int{0, 3}, []int{results[5].HomeGoals, results[5].AwayGoals}, "scored as a forfeit")
+	assert.Equal(t, []int{2, 0}, []int{results[6].HomeGoals, results[6].AwayGoals}, "listed goals are kept")
+	assert.Len(t, tbl.Remaining(), 1, "nor pending")
+}
+
+func TestTableRules(t *testing.T) {
+	teamX := models.TeamRef{ID: 10, Name: "X"}
+	teamY := models.TeamRef{ID: 11, Name: "Y"}
+	teamV := models.TeamRef{ID: 12, Name: "V"}
+	teamW := models.TeamRef{ID: 13, Name: "W"}
+	fixtures := []models.Fixture{
+		fixturetest.Fixture(1, 1, "Round 1", teamY, teamX, 1, 0, models.StatusFinished),
+		fixturetest.Fixture(2, 2, "Round 2", teamX, teamV, 5, 0, models.StatusFinished),
+		fixturetest.Fixture(3, 3, "Round 3", teamX, teamW, 0, 0, models.StatusFinished),
+		fixturetest.Fixture(4, 3, "Round 3", teamY, teamV, 1, 1, models.StatusFinished),
+	}
+
+	rows := table.New(fixtures, table.DefaultRules).Standings()
+	assert.Equal(t, []string{"X", "Y", "W", "V"}, teamOrder(rows), "goal difference first")
+
+	headToHead := table.Rules{Tiebreakers: []table.Tiebreaker{table.HeadToHead, table.GoalDifference}}
+	rows = table.New(fixtures, headToHead).Standings()
+	assert.Equal(t, []string{"Y", "X", "W", "V"}, teamOrder(rows), "Y beat X")
+	assert.Equal(t, 4, rows[0].Points, "the zero points system is three points for a win")
+
+	rows = table.New(fixtures, table.Rules{Tiebreakers: []table.Tiebreaker{table.HeadToHead}}).Standings()
+	assert.Equal(t, []string{"Y", "X", "V", "W"}, teamOrder(rows), "V and W never met, so they are ordered by name")
+
+	rows = table.New(fixtures, table.Rules{Points: table.TwoPoints, Adjustments: map[int]int{11: -2}, FormLength: 2}).Standings()
+	assert.Equal(t, []string{"X", "V", "W", "Y"}, teamOrder(rows), "the deduction drops Y level with V and W")
+	assert.Equal(t, 3, rows[0].Points)
+	assert.Equal(t, "WD", rows[0].Form)
+	assert.Equal(t, 1, rows[3].Points)
+
+	assert.Equal(t, "head-to-head points", table.HeadToHead.String())
+}
+
+func TestTableWhatIf(t *testing.T) {
+	tbl := table.New(seasonFixtures(), table.DefaultRules)
+	projected := tbl.With(table.Result{
+		FixtureID: 6, Round: "Round 3",
+		Home: fixturetest.TeamB, Away: fixturetest.TeamD,
+		HomeGoals: 0, AwayGoals: 2,
+	})
+
+	assert.Empty(t, projected.Remaining())
+	rows := projected.Standings()
+	assert.Equal(t, []string{"A", "D", "B", "C"}, teamOrder(rows))
+	assert.Equal(t, 4, rows[1].Points)
+	// The original table is left untouched.
+	assert.Equal(t, []string{"A", "B", "C", "D"}, teamOrder(tbl.Standings()))
+
+	// Replacing a played result.
+	rows = tbl.With(table.Result{FixtureID: 1, Home: fixturetest.TeamA, Away: fixturetest.TeamB, HomeGoals: 0, AwayGoals: 1}).Standings()
+	assert.Equal(t, 6, rows[0].Points)
+	assert.Equal(t, "B", rows[0].Team.Name)
+}
+
+func TestTableFromResponse(t *testing.T) {
+	body := `{"errors": [], "results": 2, "response": [
+		{"fixture": {"id": 1, "date": "2023-08-11T19:00:00+00:00", "status": {"short": "FT"}},
+		 "league": {"id": 39, "round": "Regular Season - 1"},
+		 "teams": {"home": {"id": 44, "name": "Burnley"}, "away": {"id": 50, "name": "Manchester City"}},
+		 "goals": {"home": 0, "away": 3}},
+		{"fixture": {"id": 2, "date": "2023-08-12T12:00:00+00:00", "status": {"short": "NS"}},
+		 "league": {"id": 39, "round": "Regular Season - 1"},
+		 "teams": {"home": {"id": 42, "name": "Arsenal"}, "away": {"id": 65, "name": "Nottingham Forest"}},
+		 "goals": {"home": null, "away": null}}
+	]}`
+	var resp models.FixturesResponse
+	assert.NoError(t, json.Unmarshal([]byte(body), &resp))
+
+	rows := table.FromResponse(&resp, table.DefaultRules).Standings()
+	assert.Equal(t, []string{"Manchester City", "Arsenal", "Nottingham Forest", "Burnley"}, teamOrder(rows))
+	assert.Equal(t, 3, rows[0].Points)
+}
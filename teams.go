@@ -1,6 +1,7 @@
 package client
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 
@@ -38,8 +39,22 @@ const (
 func (c *Client) Teams(
 	params map[string]any,
 ) (*models.TeamsResponse, error) {
+	return c.TeamsContext(context.Background(), params)
+}
+
+// TeamsContext is like Teams but with a context.
+func (c *Client) TeamsContext(
+	ctx context.Context,
+	params map[string]any,
+) (*models.TeamsResponse, error) {
+	// Validate the parameters
+	if err := c.validateParams(teamsEndpoint, params); err != nil {
+		return nil, err
+	}
+
 	endpointURL := fmt.Sprintf("%s%s", c.Domain, teamsEndpoint)
 	body, err := c.get(
+		ctx,
 		c.buildURL(
 			endpointURL,
 			params,
@@ -61,6 +76,11 @@ func (c *Client) Teams(
 	return &resp, nil
 }
 
+// TeamsWith is like TeamsContext but takes typed parameters.
+func (c *Client) TeamsWith(ctx context.Context, params TeamsParams) (*models.TeamsResponse, error) {
+	return c.TeamsContext(ctx, params.Encode())
+}
+
 // TeamsStatistics hits the /teams/statistics endpoint
 /*
 	- league (Type: integer) (required)
@@ -75,8 +95,22 @@ func (c *Client) Teams(
 func (c *Client) TeamsStatistics(
 	params map[string]any,
 ) (*models.TeamsStatisticsResponse, error) {
+	return c.TeamsStatisticsContext(context.Background(), params)
+}
+
+// TeamsStatisticsContext is like TeamsStatistics but with a context.
+func (c *Client) TeamsStatisticsContext(
+	ctx context.Context,
+	params map[string]any,
+) (*models.TeamsStatisticsResponse, error) {
+	// Validate the parameters
+	if err := c.validateParams(teamsStatisticsEndpoint, params); err != nil {
+		return nil, err
+	}
+
 	endpointURL := fmt.Sprintf("%s%s", c.Domain, teamsStatisticsEndpoint)
 	body, err := c.get(
+		ctx,
 		c.buildURL(
 			endpointURL,
 			params,
@@ -98,6 +132,11 @@ func (c *Client) TeamsStatistics(
 	return &resp, nil
 }
 
+// TeamsStatisticsWith is like TeamsStatisticsContext but takes typed parameters.
+func (c *Client) TeamsStatisticsWith(ctx context.Context, params TeamsStatisticsParams) (*models.TeamsStatisticsResponse, error) {
+	return c.TeamsStatisticsContext(ctx, params.Encode())
+}
+
 // TeamsSeasons hits the /teams/seasons endpoint. It returns the list of
 // seasons available for a team as 4-digit years.
 /*
@@ -106,14 +145,23 @@ func (c *Client) TeamsStatistics(
 */
 func (c *Client) TeamsSeasons(
 	params map[string]any,
+) (*models.SeasonsResponse, error) {
+	return c.TeamsSeasonsContext(context.Background(), params)
+}
+
+// TeamsSeasonsContext is like TeamsSeasons but with a context.
+func (c *Client) TeamsSeasonsContext(
+	ctx context.Context,
+	params map[string]any,
 ) (*models.SeasonsResponse, error) {
 	// Validate the parameters
-	if err := requireIntParams(params, "team"); err != nil {
+	if err := c.validateParams(teamsSeasonsEndpoint, params); err != nil {
 		return nil, err
 	}
 
 	endpointURL := fmt.Sprintf("%s%s", c.Domain, teamsSeasonsEndpoint)
 	body, err := c.get(
+		ctx,
 		c.buildURL(
 			endpointURL,
 			params,
@@ -135,12 +183,22 @@ func (c *Client) TeamsSeasons(
 	return &resp, nil
 }
 
+// TeamsSeasonsWith is like TeamsSeasonsContext but takes typed parameters.
+func (c *Client) TeamsSeasonsWith(ctx context.Context, params TeamsSeasonsParams) (*models.SeasonsResponse, error) {
+	return c.TeamsSeasonsContext(ctx, params.Encode())
+}
+
 // TeamsCountries hits the /teams/countries endpoint. It returns the list of
 // countries available for the teams endpoint. This endpoint takes no
 // parameters.
 func (c *Client) TeamsCountries() (*models.CountriesResponse, error) {
+	return c.TeamsCountriesContext(context.Background())
+}
+
+// TeamsCountriesContext is like TeamsCountries but with a context.
+func (c *Client) TeamsCountriesContext(ctx context.Context) (*models.CountriesResponse, error) {
 	endpointURL := fmt.Sprintf("%s%s", c.Domain, teamsCountriesEndpoint)
-	body, err := c.get(endpointURL)
+	body, err := c.get(ctx, endpointURL)
 	if err != nil {
 		return nil, fmt.Errorf("error getting teams countries: %w", err)
 	}
@@ -119,10 +119,10 @@ func TestTeamsStatistics(t *testing.T) {
 		{
 			name:          "team statistics request with missing required params",
 			params:        map[string]any{"league": 39},
-			responseBody:  `{"error": "Missing required parameters"}`,
-			statusCode:    http.StatusBadRequest,
+			responseBody:  ``,
+			statusCode:    http.StatusOK,
 			expectError:   true,
-			errorContains: "API request failed with status 400",
+			errorContains: "'season' is required",
 		},
 		{
 			name:          "team statistics request with invalid JSON response",
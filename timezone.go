@@ -1,6 +1,7 @@
 package client
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 
@@ -14,8 +15,13 @@ const (
 // Timezone hits the /timezone endpoint. It returns the list of timezones that
 // can be used in the fixtures endpoints. This endpoint takes no parameters.
 func (c *Client) Timezone() (*models.TimezoneResponse, error) {
+	return c.TimezoneContext(context.Background())
+}
+
+// TimezoneContext is like Timezone but with a context.
+func (c *Client) TimezoneContext(ctx context.Context) (*models.TimezoneResponse, error) {
 	endpointURL := fmt.Sprintf("%s%s", c.Domain, timezoneEndpoint)
-	body, err := c.get(endpointURL)
+	body, err := c.get(ctx, endpointURL)
 	if err != nil {
 		return nil, fmt.Errorf("error getting timezone: %w", err)
 	}
@@ -1,6 +1,7 @@
 package client
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 
@@ -21,15 +22,24 @@ const (
 */
 func (c *Client) Transfers(
 	params map[string]any,
+) (*models.TransfersResponse, error) {
+	return c.TransfersContext(context.Background(), params)
+}
+
+// TransfersContext is like Transfers but with a context.
+func (c *Client) TransfersContext(
+	ctx context.Context,
+	params map[string]any,
 ) (*models.TransfersResponse, error) {
 	// Validate the parameters
-	if err := requireOneIntParam(params, "team", "player"); err != nil {
+	if err := c.validateParams(transfersEndpoint, params); err != nil {
 		return nil, err
 	}
 
 	endpointURL := fmt.Sprintf("%s%s", c.Domain, transfersEndpoint)
 
 	body, err := c.get(
+		ctx,
 		c.buildURL(
 			endpointURL,
 			params,
@@ -50,3 +60,8 @@ func (c *Client) Transfers(
 
 	return &resp, nil
 }
+
+// TransfersWith is like TransfersContext but takes typed parameters.
+func (c *Client) TransfersWith(ctx context.Context, params TransfersParams) (*models.TransfersResponse, error) {
+	return c.TransfersContext(ctx, params.Encode())
+}
@@ -1,6 +1,7 @@
 package client
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 
@@ -22,14 +23,23 @@ const (
 */
 func (c *Client) Trophies(
 	params map[string]any,
+) (*models.TrophiesResponse, error) {
+	return c.TrophiesContext(context.Background(), params)
+}
+
+// TrophiesContext is like Trophies but with a context.
+func (c *Client) TrophiesContext(
+	ctx context.Context,
+	params map[string]any,
 ) (*models.TrophiesResponse, error) {
 	// Validate the parameters
-	if err := requireOneIntParam(params, "coach", "player"); err != nil {
+	if err := c.validateParams(trophiesEndpoint, params); err != nil {
 		return nil, err
 	}
 
 	endpointURL := fmt.Sprintf("%s%s", c.Domain, trophiesEndpoint)
 	body, err := c.get(
+		ctx,
 		c.buildURL(
 			endpointURL,
 			params,
@@ -50,3 +60,8 @@ func (c *Client) Trophies(
 
 	return &resp, nil
 }
+
+// TrophiesWith is like TrophiesContext but takes typed parameters.
+func (c *Client) TrophiesWith(ctx context.Context, params TrophiesParams) (*models.TrophiesResponse, error) {
+	return c.TrophiesContext(ctx, params.Encode())
+}
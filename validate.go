@@ -2,42 +2,475 @@ package client
 
 import (
 	"fmt"
+	"regexp"
+	"sort"
+	"strconv"
 	"strings"
+
+	"github.com/0ffsideCompass/api-football-go-client/models"
 )
 
-// requireIntParams ensures that every given key is present in params and holds
-// an integer value (an int, or a whole-number float64 as produced by JSON
-// decoding).
-func requireIntParams(params map[string]any, keys ...string) error {
-	for _, key := range keys {
-		value, ok := params[key]
+const (
+	// minSearchLength is the minimum length the API accepts for 'search' values.
+	minSearchLength = 3
+	// seasonDigits is the number of digits the API expects for a season (YYYY).
+	seasonDigits = 4
+	// maxIDs is the maximum number of IDs the API accepts in an 'ids' list.
+	maxIDs = 20
+	// maxCountDigits is the maximum number of digits of a count parameter.
+	maxCountDigits = 2
+)
+
+// dateRegex matches the YYYY-MM-DD format of date parameters.
+var dateRegex = regexp.MustCompile(`^\d{4}-\d{2}-\d{2}$`)
+
+// paramKind is the type of a query parameter.
+type paramKind int
+
+const (
+	// intParam is an integer, such as an ID or a count.
+	intParam paramKind = iota
+	// countParam is a positive integer of at most maxCountDigits digits.
+	countParam
+	// seasonParam is a season as a 4-digit year.
+	seasonParam
+	// dateParam is a YYYY-MM-DD date.
+	dateParam
+	// stringParam is free text.
+	stringParam
+	// searchParam is free text with a minimum length.
+	searchParam
+	// flagParam is "true" or "false".
+	flagParam
+	// idListParam is up to maxIDs integers joined with "-".
+	idListParam
+	// idPairParam is exactly two integers joined with "-".
+	idPairParam
+	// liveParam is "all", or league IDs joined with "-".
+	liveParam
+	// statusParam is fixture statuses joined with "-".
+	statusParam
+)
+
+// paramSchema declares the query parameters an endpoint accepts and the
+// rules they must follow.
+type paramSchema struct {
+	// params maps every parameter the endpoint accepts to its kind.
+	params map[string]paramKind
+	// required lists the parameters that must always be present.
+	required []string
+	// oneOf lists groups of parameters of which at least one must be present.
+	oneOf [][]string
+	// nonEmpty requires at least one parameter to be present.
+	nonEmpty bool
+	// requires maps a parameter to a group of which at least one must be
+	// present along with it.
+	requires map[string][]string
+	// minSearch is the minimum length of search parameters. Zero means
+	// minSearchLength.
+	minSearch int
+}
+
+// endpointSchemas holds the schema of every endpoint that takes parameters.
+var endpointSchemas = map[string]paramSchema{
+	countriesEndpoint: {
+		params: map[string]paramKind{"name": stringParam, "code": stringParam, "search": searchParam},
+	},
+	leaguesEndpoint: {
+		params: map[string]paramKind{
+			"id": intParam, "name": stringParam, "country": stringParam, "code": stringParam,
+			"season": seasonParam, "team": intParam, "type": stringParam, "current": flagParam,
+			"search": searchParam, "last": intParam,
+		},
+	},
+	teamsEndpoint: {
+		params: map[string]paramKind{
+			"id": intParam, "name": stringParam, "league": intParam, "season": seasonParam,
+			"country": stringParam, "code": stringParam, "venue": intParam, "search": searchParam,
+		},
+		nonEmpty: true,
+	},
+	teamsStatisticsEndpoint: {
+		params:   map[string]paramKind{"league": intParam, "season": seasonParam, "team": intParam, "date": dateParam},
+		required: []string{"league", "season", "team"},
+	},
+	teamsSeasonsEndpoint: {
+		params:   map[string]paramKind{"team": intParam},
+		required: []string{"team"},
+	},
+	venuesEndpoint: {
+		params: map[string]paramKind{
+			"id": intParam, "name": stringParam, "city": stringParam, "country": stringParam, "search": searchParam,
+		},
+		nonEmpty: true,
+	},
+	standingsEndpoint: {
+		params:   map[string]paramKind{"league": intParam, "season": seasonParam, "team": intParam},
+		required: []string{"season"},
+		oneOf:    [][]string{{"league", "team"}},
+	},
+	fixtureEndpoint: {
+		params: map[string]paramKind{
+			"id": intParam, "ids": idListParam, "live": liveParam, "date": dateParam,
+			"league": intParam, "season": seasonParam, "team": intParam, "last": countParam,
+			"next": countParam, "from": dateParam, "to": dateParam, "round": stringParam,
+			"status": statusParam, "venue": intParam, "timezone": stringParam,
+		},
+		nonEmpty: true,
+		requires: map[string][]string{"from": {"to"}, "to": {"from"}},
+	},
+	fixtureRoundsEndpoint: {
+		params: map[string]paramKind{
+			"league": intParam, "season": seasonParam, "current": flagParam, "dates": flagParam, "timezone": stringParam,
+		},
+		required: []string{"league", "season"},
+	},
+	fixturesEventsEndpoint: {
+		params:   map[string]paramKind{"fixture": intParam, "team": intParam, "player": intParam, "type": stringParam},
+		required: []string{"fixture"},
+	},
+	fixtureLineupsEndpoint: {
+		params:   map[string]paramKind{"fixture": intParam, "team": intParam, "player": intParam, "type": stringParam},
+		required: []string{"fixture"},
+	},
+	fixtureHeadToHeadEndpoint: {
+		params: map[string]paramKind{
+			"h2h": idPairParam, "date": dateParam, "league": intParam, "season": seasonParam,
+			"last": intParam, "next": intParam, "from": dateParam, "to": dateParam,
+			"status": statusParam, "venue": intParam, "timezone": stringParam,
+		},
+		required: []string{"h2h"},
+		requires: map[string][]string{"from": {"to"}, "to": {"from"}},
+	},
+	fixtureStatisticsEndpoint: {
+		params:   map[string]paramKind{"fixture": intParam, "team": intParam, "type": stringParam},
+		required: []string{"fixture"},
+	},
+	fixturePlayerEndpoint: {
+		params:   map[string]paramKind{"fixture": intParam, "team": intParam},
+		required: []string{"fixture"},
+	},
+	injuriesEndpoint: {
+		params: map[string]paramKind{
+			"league": intParam, "season": seasonParam, "fixture": intParam, "team": intParam,
+			"player": intParam, "date": dateParam, "timezone": stringParam,
+		},
+		nonEmpty: true,
+		requires: map[string][]string{"league": {"season"}, "team": {"season"}, "player": {"season"}},
+	},
+	predictionsEndpoint: {
+		params:   map[string]paramKind{"fixture": intParam},
+		required: []string{"fixture"},
+	},
+	coachsEndpoint: {
+		params: map[string]paramKind{"id": intParam, "team": intParam, "search": searchParam},
+		oneOf:  [][]string{{"team", "id", "search"}},
+	},
+	playersSeasonsEndpoint: {
+		params:   map[string]paramKind{"player": intParam},
+		required: []string{"player"},
+	},
+	playersEndpoint: {
+		params: map[string]paramKind{
+			"id": intParam, "team": intParam, "league": intParam, "season": seasonParam,
+			"search": searchParam, "page": intParam,
+		},
+		nonEmpty: true,
+		requires: map[string][]string{"season": {"id", "league", "team"}, "search": {"league", "team"}},
+		// The API needs longer queries to search players.
+		minSearch: 4,
+	},
+	playersSquadsEndpoint: {
+		params:   map[string]paramKind{"team": intParam, "player": intParam},
+		required: []string{"team"},
+	},
+	playersTopScorersEndpoint: {
+		params:   map[string]paramKind{"league": intParam, "season": seasonParam},
+		required: []string{"league", "season"},
+	},
+	playersTopAssistsEndpoint: {
+		params:   map[string]paramKind{"league": intParam, "season": seasonParam},
+		required: []string{"league", "season"},
+	},
+	playersTopYellowCardsEndpoint: {
+		params:   map[string]paramKind{"league": intParam, "season": seasonParam},
+		required: []string{"league", "season"},
+	},
+	playersTopRedCardsEndpoint: {
+		params:   map[string]paramKind{"league": intParam, "season": seasonParam},
+		required: []string{"league", "season"},
+	},
+	playersProfilesEndpoint: {
+		params: map[string]paramKind{"player": intParam, "search": searchParam, "page": intParam},
+	},
+	playersTeamsEndpoint: {
+		params:   map[string]paramKind{"player": intParam},
+		required: []string{"player"},
+	},
+	transfersEndpoint: {
+		params: map[string]paramKind{"player": intParam, "team": intParam},
+		oneOf:  [][]string{{"team", "player"}},
+	},
+	trophiesEndpoint: {
+		params: map[string]paramKind{"player": intParam, "coach": intParam},
+		oneOf:  [][]string{{"coach", "player"}},
+	},
+	sidelinedEndpoint: {
+		params: map[string]paramKind{"player": intParam, "coach": intParam},
+		oneOf:  [][]string{{"coach", "player"}},
+	},
+	oddsEndpoint: {
+		params: map[string]paramKind{
+			"fixture": intParam, "league": intParam, "season": seasonParam, "date": dateParam,
+			"timezone": stringParam, "page": intParam, "bookmaker": intParam, "bet": intParam,
+		},
+		nonEmpty: true,
+	},
+	oddsMappingEndpoint: {
+		params: map[string]paramKind{"page": intParam},
+	},
+	oddsBookmakersEndpoint: {
+		params: map[string]paramKind{"id": intParam, "search": stringParam},
+	},
+	oddsBetsEndpoint: {
+		params: map[string]paramKind{"id": intParam, "search": stringParam},
+	},
+	oddsLiveEndpoint: {
+		params: map[string]paramKind{"fixture": intParam, "league": intParam, "bet": intParam},
+	},
+	oddsLiveBetsEndpoint: {
+		params: map[string]paramKind{"id": intParam, "search": stringParam},
+	},
+}
+
+// validateParams checks params against the schema of endpoint and returns a
+// *ValidationError listing every violation, or nil. Values are checked in
+// the normalized form they would be sent in, so ints, JSON float64s,
+// numeric strings and time.Time dates are all accepted where they encode
+// to a valid value. Unknown parameters are only rejected in strict mode.
+func (c *Client) validateParams(endpoint string, params map[string]any) error {
+	schema, ok := endpointSchemas[endpoint]
+	if !ok {
+		return nil
+	}
+	values := NewCanonicalRequest(endpoint, params).Params
+
+	var violations []string
+	if schema.nonEmpty && len(values) == 0 {
+		violations = append(violations, "at least one parameter must be provided")
+	}
+	for _, key := range schema.required {
+		if _, ok := values[key]; !ok {
+			violations = append(violations, fmt.Sprintf("'%s' is required", key))
+		}
+	}
+	for _, group := range schema.oneOf {
+		if !hasAny(values, group) {
+			violations = append(violations, fmt.Sprintf("at least one of %s must be provided", quoteList(group)))
+		}
+	}
+	for _, key := range sortedKeys(schema.requires) {
+		group := schema.requires[key]
+		if _, ok := values[key]; !ok || hasAny(values, group) {
+			continue
+		}
+		if len(group) == 1 {
+			violations = append(violations, fmt.Sprintf("'%s' is required when '%s' is provided", group[0], key))
+		} else {
+			violations = append(violations, fmt.Sprintf("one of %s is required when '%s' is provided", quoteList(group), key))
+		}
+	}
+	for _, key := range sortedKeys(values) {
+		kind, ok := schema.params[key]
 		if !ok {
-			return fmt.Errorf("'%s' is required", key)
+			if c.strictParams {
+				violations = append(violations, unknownParam(key, schema.params))
+			}
+			continue
 		}
-		if _, err := asInt(value); err != nil {
-			return fmt.Errorf("'%s' must be an integer", key)
+		if msg := checkParam(kind, values[key], schema.minSearch); msg != "" {
+			violations = append(violations, fmt.Sprintf("'%s' %s", key, msg))
 		}
 	}
-	return nil
+
+	if len(violations) == 0 {
+		return nil
+	}
+	return &ValidationError{Endpoint: endpoint, Violations: violations}
 }
 
-// requireOneIntParam ensures that at least one of the given keys is present in
-// params and that every provided key holds an integer value (an int, or a
-// whole-number float64 as produced by JSON decoding).
-func requireOneIntParam(params map[string]any, keys ...string) error {
-	found := false
-	for _, key := range keys {
-		value, ok := params[key]
-		if !ok {
-			continue
+// checkParam checks a normalized value of the given kind. It returns what
+// is wrong with it, to be prefixed with the parameter name, or "".
+func checkParam(kind paramKind, value string, minSearch int) string {
+	switch kind {
+	case intParam:
+		if _, err := strconv.Atoi(value); err != nil {
+			return "must be an integer"
+		}
+	case countParam:
+		if n, err := strconv.Atoi(value); err != nil || n <= 0 || len(value) > maxCountDigits {
+			return fmt.Sprintf("must be a positive integer of at most %d digits", maxCountDigits)
+		}
+	case seasonParam:
+		if _, err := strconv.Atoi(value); err != nil {
+			return "must be an integer"
+		}
+		if len(value) != seasonDigits {
+			return fmt.Sprintf("must be %d digits", seasonDigits)
+		}
+	case dateParam:
+		if !isValidDateFormat(value) {
+			return "must be in the format 'YYYY-MM-DD'"
+		}
+	case searchParam:
+		if minSearch == 0 {
+			minSearch = minSearchLength
+		}
+		if len(value) < minSearch {
+			return fmt.Sprintf("must be at least %d characters long", minSearch)
+		}
+	case flagParam:
+		if value != string(FlagTrue) && value != string(FlagFalse) {
+			return "must be 'true' or 'false'"
+		}
+	case idListParam:
+		ids := strings.Split(value, "-")
+		if !allInts(ids) {
+			return "must be integers separated by '-'"
 		}
-		found = true
-		if _, err := asInt(value); err != nil {
-			return fmt.Errorf("%s must be an integer", key)
+		if len(ids) > maxIDs {
+			return fmt.Sprintf("must hold at most %d IDs", maxIDs)
 		}
+	case idPairParam:
+		if ids := strings.Split(value, "-"); len(ids) != 2 || !allInts(ids) {
+			return "must be two integers in the format 'id-id'"
+		}
+	case liveParam:
+		if value != "all" && !allInts(strings.Split(value, "-")) {
+			return "must be 'all' or integers separated by '-'"
+		}
+	case statusParam:
+		if _, err := models.ParseStatusFilter(value); err != nil {
+			return "must be fixture statuses separated by '-': " + err.Error()
+		}
+	}
+	return ""
+}
+
+// unknownParam describes a parameter missing from known, suggesting the
+// closest known name when one is near enough to be a likely typo.
+func unknownParam(key string, known map[string]paramKind) string {
+	best, bestDistance := "", -1
+	for _, name := range sortedKeys(known) {
+		if d := editDistance(key, name); bestDistance < 0 || d < bestDistance {
+			best, bestDistance = name, d
+		}
+	}
+	if best != "" && bestDistance <= maxSuggestionDistance(key) {
+		return fmt.Sprintf("unknown parameter '%s', did you mean '%s'?", key, best)
 	}
-	if !found {
-		return fmt.Errorf("at least one of '%s' must be provided", strings.Join(keys, "' or '"))
+	return fmt.Sprintf("unknown parameter '%s'", key)
+}
+
+// maxSuggestionDistance is the largest edit distance at which a known name
+// is suggested for key: 1 for short names, up to a third of longer ones.
+func maxSuggestionDistance(key string) int {
+	return max(1, len(key)/3)
+}
+
+// editDistance returns the Damerau-Levenshtein distance between a and b,
+// counting a swap of two adjacent characters ("leauge") as one edit.
+func editDistance(a, b string) int {
+	prev2 := make([]int, len(b)+1)
+	prev := make([]int, len(b)+1)
+	cur := make([]int, len(b)+1)
+	for j := range prev {
+		prev[j] = j
+	}
+	for i := 1; i <= len(a); i++ {
+		cur[0] = i
+		for j := 1; j <= len(b); j++ {
+			cost := 1
+			if a[i-1] == b[j-1] {
+				cost = 0
+			}
+			cur[j] = min(prev[j]+1, cur[j-1]+1, prev[j-1]+cost)
+			if i > 1 && j > 1 && a[i-1] == b[j-2] && a[i-2] == b[j-1] {
+				cur[j] = min(cur[j], prev2[j-2]+1)
+			}
+		}
+		prev2, prev, cur = prev, cur, prev2
+	}
+	return prev[len(b)]
+}
+
+// asInt converts an int, a whole-number float64, or a numeric string to int.
+// All three shapes occur in practice: Go callers pass ints, JSON-decoded
+// params arrive as float64, and callers forwarding path/query values pass
+// strings.
+func asInt(value any) (int, error) {
+	switch v := value.(type) {
+	case int:
+		return v, nil
+	case float64:
+		if v != float64(int(v)) {
+			return 0, fmt.Errorf("not an integer")
+		}
+		return int(v), nil
+	case string:
+		n, err := strconv.Atoi(v)
+		if err != nil {
+			return 0, fmt.Errorf("not an integer")
+		}
+		return n, nil
+	default:
+		return 0, fmt.Errorf("not an integer")
+	}
+}
+
+// Helper function to check the date format (YYYY-MM-DD)
+func isValidDateFormat(dateStr string) bool {
+	return dateRegex.MatchString(dateStr)
+}
+
+// allInts reports whether every string in parts is an integer.
+func allInts(parts []string) bool {
+	for _, p := range parts {
+		if _, err := strconv.Atoi(p); err != nil {
+			return false
+		}
+	}
+	return true
+}
+
+// hasAny reports whether any of keys is present in values.
+func hasAny(values map[string]string, keys []string) bool {
+	for _, key := range keys {
+		if _, ok := values[key]; ok {
+			return true
+		}
+	}
+	return false
+}
+
+// quoteList formats keys as "'a' or 'b'", or "'a', 'b', or 'c'".
+func quoteList(keys []string) string {
+	quoted := make([]string, len(keys))
+	for i, key := range keys {
+		quoted[i] = "'" + key + "'"
+	}
+	if len(quoted) <= 2 {
+		return strings.Join(quoted, " or ")
+	}
+	return strings.Join(quoted[:len(quoted)-1], ", ") + ", or " + quoted[len(quoted)-1]
+}
+
+// sortedKeys returns the keys of m in sorted order.
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
 	}
-	return nil
+	sort.Strings(keys)
+	return keys
 }
@@ -1,6 +1,7 @@
 package client
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 
@@ -29,8 +30,22 @@ At least one of the following parameters must be passed:
 func (c *Client) Venues(
 	params map[string]any,
 ) (*models.VenuesResponse, error) {
+	return c.VenuesContext(context.Background(), params)
+}
+
+// VenuesContext is like Venues but with a context.
+func (c *Client) VenuesContext(
+	ctx context.Context,
+	params map[string]any,
+) (*models.VenuesResponse, error) {
+	// Validate the parameters
+	if err := c.validateParams(venuesEndpoint, params); err != nil {
+		return nil, err
+	}
+
 	endpointURL := fmt.Sprintf("%s%s", c.Domain, venuesEndpoint)
 	body, err := c.get(
+		ctx,
 		c.buildURL(
 			endpointURL,
 			params,
@@ -51,3 +66,8 @@ func (c *Client) Venues(
 
 	return &resp, nil
 }
+
+// VenuesWith is like VenuesContext but takes typed parameters.
+func (c *Client) VenuesWith(ctx context.Context, params VenuesParams) (*models.VenuesResponse, error) {
+	return c.VenuesContext(ctx, params.Encode())
+}